@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -19,25 +21,25 @@ type Adjunct struct {
 	Description     string
 	Category        string // This will always be set to "misc"
 	CategoryDisplay string // This will always be set to "Miscellaneous"
-	CreateDate      string
+	CreateDate      Timestamp
 }
 
 // AdjunctList represents a single "page" containing a slice of Adjuncts.
-type AdjunctList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Adjuncts      []Adjunct `json:"data"`
-}
+type AdjunctList = Page[Adjunct]
 
 // List returns all Adjuncts on the given page.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/adjunct_index#1
 func (as *AdjunctService) List(page int) (al AdjunctList, err error) {
+	return as.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (as *AdjunctService) ListContext(ctx context.Context, page int) (al AdjunctList, err error) {
 	// GET: /adjuncts
 
 	var req *http.Request
-	req, err = as.c.NewRequest("GET", "/adjuncts", &Page{page})
+	req, err = as.c.NewRequestWithContext(ctx, "GET", "/adjuncts", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -46,22 +48,52 @@ func (as *AdjunctService) List(page int) (al AdjunctList, err error) {
 	return
 }
 
+// All returns an iterator over the Adjuncts on every page.
+// Pages are requested as the iteration proceeds.
+func (as *AdjunctService) All() iter.Seq2[Adjunct, error] {
+	return as.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (as *AdjunctService) AllContext(ctx context.Context) iter.Seq2[Adjunct, error] {
+	return paginate(1, func(page int) ([]Adjunct, int, error) {
+		l, err := as.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Adjuncts on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (as *AdjunctService) FetchAll(workers int) ([]Adjunct, error) {
+	return as.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (as *AdjunctService) FetchAllContext(ctx context.Context, workers int) ([]Adjunct, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Adjunct, int, error) {
+		l, err := as.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get obtains the Adjunct with the given Adjunct ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/adjunct_index#2
 func (as *AdjunctService) Get(id int) (a Adjunct, err error) {
+	return as.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (as *AdjunctService) GetContext(ctx context.Context, id int) (a Adjunct, err error) {
 	// GET: /adjunct/:adjunctID
 	var req *http.Request
-	req, err = as.c.NewRequest("GET", fmt.Sprintf("/adjunct/%d", id), nil)
+	req, err = as.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/adjunct/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Adjunct
-		Message string
-	}{}
+	var resp Envelope[Adjunct]
 	err = as.c.Do(req, &resp)
 	return resp.Data, err
 }
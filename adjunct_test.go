@@ -53,12 +53,12 @@ func TestAdjunctList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(al.Adjuncts) <= 0 {
+	if len(al.Data) <= 0 {
 		t.Fatal("Expected >0 adjuncts")
 	}
 
 	c := "misc"
-	for _, a := range al.Adjuncts {
+	for _, a := range al.Data {
 		if c != a.Category {
 			t.Fatalf("Adjunct Category = %s, wanted %s", a.Category, c)
 		}
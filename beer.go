@@ -1,8 +1,11 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // BeerService provides access to the BreweryDB Beer API. Use Client.Beer.
@@ -11,12 +14,7 @@ type BeerService struct {
 }
 
 // BeerList represents a "page" containing a slice of Beers.
-type BeerList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Beers         []Beer `json:"data"`
-}
+type BeerList = Page[Beer]
 
 // BeerOrder represents the ordering of a list of Beers.
 type BeerOrder string
@@ -57,16 +55,16 @@ type BeerListRequest struct {
 	Page               int       `url:"p"`
 	IDs                string    `url:"ids,omitempty"` // IDs of the beers to return, comma separated. Max 10.
 	Name               string    `url:"name,omitempty"`
-	ABV                string    `url:"abv,omitempty"`
-	IBU                string    `url:"ibu,omitempty"`
+	ABV                Range     `url:"abv,omitempty"`
+	IBU                Range     `url:"ibu,omitempty"`
 	GlasswareID        int       `url:"glasswareId,omitempty"`
 	SrmID              int       `url:"srmId,omitempty"`
 	AvailableID        int       `url:"availableId,omitempty"`
 	StyleID            int       `url:"styleId,omitempty"`
 	IsOrganic          YesNo     `url:"isOrganic,omitempty"`
 	HasLabels          YesNo     `url:"hasLabels,omitempty"`
-	Year               int       `url:"year,omitempty"`  // YYYY
-	Since              string    `url:"since,omitempty"` // UNIX timestamp format. Max 30 days
+	Year               Range     `url:"year,omitempty"`
+	Since              time.Time `url:"since,omitempty,unix"` // Max 30 days ago
 	Status             string    `url:"status,omitempty"`
 	Order              BeerOrder `url:"order,omitempty"`
 	Sort               ListSort  `url:"sort,omitempty"`
@@ -96,9 +94,9 @@ type Beer struct {
 	Name                      string          `url:"name"` // Required
 	Description               string          `url:"description,omitempty"`
 	FoodPairings              string          `url:"foodPairings,omitempty"`
-	OriginalGravity           string          `url:"originalGravity,omitempty"`
-	ABV                       string          `url:"abv,omitempty"`
-	IBU                       string          `url:"ibu,omitempty"`
+	OriginalGravity           Number          `url:"originalGravity,omitempty"`
+	ABV                       Number          `url:"abv,omitempty"`
+	IBU                       Number          `url:"ibu,omitempty"`
 	GlasswareID               int             `url:"glasswareId,omitempty"`
 	Glass                     Glass           `url:"-"`
 	StyleID                   int             `url:"styleId"` // Required
@@ -117,11 +115,11 @@ type Beer struct {
 	BeerVariation             struct {
 		// TODO: instance of a Beer??
 	} `url:"-"`
-	SrmID      int    `url:"srmId,omitempty"`
-	SRM        SRM    `url:"-"`
-	Year       int    `url:"year,omitempty"`
-	CreateDate string `url:"-"`
-	UpdateDate string `url:"-"`
+	SrmID      int       `url:"srmId,omitempty"`
+	SRM        SRM       `url:"-"`
+	Year       int       `url:"year,omitempty"`
+	CreateDate Timestamp `url:"-"`
+	UpdateDate Timestamp `url:"-"`
 }
 
 // List returns all Beers on the page specified in the given BeerListRequest.
@@ -129,9 +127,14 @@ type Beer struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_index#1
 func (bs *BeerService) List(q *BeerListRequest) (bl BeerList, err error) {
+	return bs.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (bs *BeerService) ListContext(ctx context.Context, q *BeerListRequest) (bl BeerList, err error) {
 	// GET: /beers
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beers", q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beers", q)
 	if err != nil {
 		return
 	}
@@ -140,50 +143,109 @@ func (bs *BeerService) List(q *BeerListRequest) (bl BeerList, err error) {
 	return
 }
 
+// All returns an iterator over the Beers on every page matching the
+// given BeerListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (bs *BeerService) All(q *BeerListRequest) iter.Seq2[Beer, error] {
+	return bs.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (bs *BeerService) AllContext(ctx context.Context, q *BeerListRequest) iter.Seq2[Beer, error] {
+	var req BeerListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Beer, int, error) {
+		r := req
+		r.Page = page
+		l, err := bs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Beers on every page matching the given
+// BeerListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (bs *BeerService) FetchAll(q *BeerListRequest, workers int) ([]Beer, error) {
+	return bs.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (bs *BeerService) FetchAllContext(ctx context.Context, q *BeerListRequest, workers int) ([]Beer, error) {
+	var req BeerListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Beer, int, error) {
+		r := req
+		r.Page = page
+		l, err := bs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get queries for a single Beer with the given Beer ID.
 //
 // TODO: add withBreweries, withSocialAccounts, withIngredients request parameters
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_index#2
 func (bs *BeerService) Get(id string) (beer Beer, err error) {
+	return bs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (bs *BeerService) GetContext(ctx context.Context, id string) (beer Beer, err error) {
 	// GET: /beer/:beerId
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+id, nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+id, nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Message string
-		Data    Beer
-		Status  string
-	}{}
+	var resp Envelope[Beer]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
+// GetMany queries for the Beers with the given IDs, which are requested
+// concurrently in batches of 10. It returns the Beers found, in the order
+// of ids, and the IDs that were not found.
+func (bs *BeerService) GetMany(ids []string) ([]Beer, []string, error) {
+	return bs.GetManyContext(context.Background(), ids)
+}
+
+// GetManyContext is like GetMany but uses the given Context for the requests.
+func (bs *BeerService) GetManyContext(ctx context.Context, ids []string) ([]Beer, []string, error) {
+	id := func(v Beer) string { return v.ID }
+	return getMany(ctx, ids, id, func(ctx context.Context, ids string) ([]Beer, error) {
+		l, err := bs.ListContext(ctx, &BeerListRequest{Page: 1, IDs: ids})
+		return l.Data, err
+	})
+}
+
 // Add adds a new Beer to the BreweryDB and returns its new ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_index#3
 func (bs *BeerService) Add(b *Beer) (id string, err error) {
+	return bs.AddContext(context.Background(), b)
+}
+
+// AddContext is like Add but uses the given Context for the request.
+func (bs *BeerService) AddContext(ctx context.Context, b *Beer) (id string, err error) {
 	// POST: /beers
 	if b == nil {
 		err = fmt.Errorf("nil Beer")
 		return
 	}
 	var req *http.Request
-	req, err = bs.c.NewRequest("POST", "/beers", b)
+	req, err = bs.c.NewRequestWithContext(ctx, "POST", "/beers", b)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID string
-		}
-		Message string
-	}{}
+	var resp Envelope[struct{ ID string }]
 	err = bs.c.Do(req, &resp)
 	return resp.Data.ID, err
 }
@@ -192,16 +254,20 @@ func (bs *BeerService) Add(b *Beer) (id string, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_index#4
 func (bs *BeerService) Update(id string, b *Beer) error {
+	return bs.UpdateContext(context.Background(), id, b)
+}
+
+// UpdateContext is like Update but uses the given Context for the request.
+func (bs *BeerService) UpdateContext(ctx context.Context, id string, b *Beer) error {
 	// PUT: /beer/:beerId
 	if b == nil {
 		return fmt.Errorf("nil Beer")
 	}
-	req, err := bs.c.NewRequest("PUT", "/beer/"+id, b)
+	req, err := bs.c.NewRequestWithContext(ctx, "PUT", "/beer/"+id, b)
 	if err != nil {
 		return err
 	}
 
-	// TODO: check status==success in JSON response body?
 	return bs.c.Do(req, nil)
 }
 
@@ -209,13 +275,17 @@ func (bs *BeerService) Update(id string, b *Beer) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_index#5
 func (bs *BeerService) Delete(id string) error {
+	return bs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given Context for the request.
+func (bs *BeerService) DeleteContext(ctx context.Context, id string) error {
 	// DELETE: /beer/:beerId
-	req, err := bs.c.NewRequest("DELETE", "/beer/"+id, nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", "/beer/"+id, nil)
 	if err != nil {
 		return err
 	}
 
-	// TODO: extract and return response message
 	return bs.c.Do(req, nil)
 }
 
@@ -223,18 +293,19 @@ func (bs *BeerService) Delete(id string) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_adjunct#1
 func (bs *BeerService) ListAdjuncts(beerID string) (al []Adjunct, err error) {
+	return bs.ListAdjunctsContext(context.Background(), beerID)
+}
+
+// ListAdjunctsContext is like ListAdjuncts but uses the given Context for the request.
+func (bs *BeerService) ListAdjunctsContext(ctx context.Context, beerID string) (al []Adjunct, err error) {
 	// GET: /beer/:beerId/adjuncts
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/adjuncts", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/adjuncts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Adjunct
-		Message string
-	}{}
+	var resp Envelope[[]Adjunct]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -243,11 +314,16 @@ func (bs *BeerService) ListAdjuncts(beerID string) (al []Adjunct, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_adjunct#2
 func (bs *BeerService) AddAdjunct(beerID string, adjunctID int) error {
+	return bs.AddAdjunctContext(context.Background(), beerID, adjunctID)
+}
+
+// AddAdjunctContext is like AddAdjunct but uses the given Context for the request.
+func (bs *BeerService) AddAdjunctContext(ctx context.Context, beerID string, adjunctID int) error {
 	// POST: /beer/:beerId/adjuncts
 	q := struct {
 		ID int `url:"adjunctId"`
 	}{adjunctID}
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/adjuncts", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/adjuncts", &q)
 	if err != nil {
 		return err
 	}
@@ -259,8 +335,13 @@ func (bs *BeerService) AddAdjunct(beerID string, adjunctID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_adjunct#3
 func (bs *BeerService) DeleteAdjunct(beerID string, adjunctID int) error {
+	return bs.DeleteAdjunctContext(context.Background(), beerID, adjunctID)
+}
+
+// DeleteAdjunctContext is like DeleteAdjunct but uses the given Context for the request.
+func (bs *BeerService) DeleteAdjunctContext(ctx context.Context, beerID string, adjunctID int) error {
 	// DELETE: /beer/:beerId/adjunct/:adjunctId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/beer/%s/adjunct/%d", beerID, adjunctID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/beer/%s/adjunct/%d", beerID, adjunctID), nil)
 	if err != nil {
 		return err
 	}
@@ -271,17 +352,18 @@ func (bs *BeerService) DeleteAdjunct(beerID string, adjunctID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_brewery#1
 func (bs *BeerService) ListBreweries(id string) ([]Brewery, error) {
+	return bs.ListBreweriesContext(context.Background(), id)
+}
+
+// ListBreweriesContext is like ListBreweries but uses the given Context for the request.
+func (bs *BeerService) ListBreweriesContext(ctx context.Context, id string) ([]Brewery, error) {
 	// GET: /beer/:beerId/breweries
-	req, err := bs.c.NewRequest("GET", "/beer/"+id+"/breweries", nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+id+"/breweries", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Brewery
-		Message string
-	}{}
+	var resp Envelope[[]Brewery]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -297,6 +379,11 @@ type BeerBreweryRequest struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_brewery#2
 func (bs *BeerService) AddBrewery(beerID, breweryID string, q *BeerBreweryRequest) error {
+	return bs.AddBreweryContext(context.Background(), beerID, breweryID, q)
+}
+
+// AddBreweryContext is like AddBrewery but uses the given Context for the request.
+func (bs *BeerService) AddBreweryContext(ctx context.Context, beerID, breweryID string, q *BeerBreweryRequest) error {
 	// POST: /beer/:beerId/brewery/:breweryId
 	params := struct {
 		ID         string `url:"breweryId"`
@@ -307,7 +394,7 @@ func (bs *BeerService) AddBrewery(beerID, breweryID string, q *BeerBreweryReques
 		params.LocationID = q.LocationID
 	}
 
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/breweries", &params)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/breweries", &params)
 	if err != nil {
 		return err
 	}
@@ -320,8 +407,13 @@ func (bs *BeerService) AddBrewery(beerID, breweryID string, q *BeerBreweryReques
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_brewery#3
 func (bs *BeerService) DeleteBrewery(beerID, breweryID string, q *BeerBreweryRequest) error {
+	return bs.DeleteBreweryContext(context.Background(), beerID, breweryID, q)
+}
+
+// DeleteBreweryContext is like DeleteBrewery but uses the given Context for the request.
+func (bs *BeerService) DeleteBreweryContext(ctx context.Context, beerID, breweryID string, q *BeerBreweryRequest) error {
 	// DELETE: /beer/:beerId/brewery/:breweryId
-	req, err := bs.c.NewRequest("DELETE", "/beer/"+beerID+"/brewery/"+breweryID, q)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", "/beer/"+beerID+"/brewery/"+breweryID, q)
 	if err != nil {
 		return err
 	}
@@ -334,22 +426,23 @@ func (bs *BeerService) DeleteBrewery(beerID, breweryID string, q *BeerBreweryReq
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_event#1
 func (bs *BeerService) ListEvents(beerID string, onlyWinners bool) (el []Event, err error) {
+	return bs.ListEventsContext(context.Background(), beerID, onlyWinners)
+}
+
+// ListEventsContext is like ListEvents but uses the given Context for the request.
+func (bs *BeerService) ListEventsContext(ctx context.Context, beerID string, onlyWinners bool) (el []Event, err error) {
 	// GET: /beer/:beerId/events
 	q := struct {
 		OnlyWinners YesNo `url:"onlyWinners,omitempty"`
 	}{YesNo(onlyWinners)}
 
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/events", &q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/events", &q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Event
-		Message string
-	}{}
+	var resp Envelope[[]Event]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -358,18 +451,19 @@ func (bs *BeerService) ListEvents(beerID string, onlyWinners bool) (el []Event,
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_fermentable#1
 func (bs *BeerService) ListFermentables(beerID string) (fl []Fermentable, err error) {
+	return bs.ListFermentablesContext(context.Background(), beerID)
+}
+
+// ListFermentablesContext is like ListFermentables but uses the given Context for the request.
+func (bs *BeerService) ListFermentablesContext(ctx context.Context, beerID string) (fl []Fermentable, err error) {
 	// GET: /beer/:beerId/fermentables
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/fermentables", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/fermentables", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Fermentable
-		Message string
-	}{}
+	var resp Envelope[[]Fermentable]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -378,11 +472,16 @@ func (bs *BeerService) ListFermentables(beerID string) (fl []Fermentable, err er
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_fermentable#2
 func (bs *BeerService) AddFermentable(beerID string, fermentableID int) error {
+	return bs.AddFermentableContext(context.Background(), beerID, fermentableID)
+}
+
+// AddFermentableContext is like AddFermentable but uses the given Context for the request.
+func (bs *BeerService) AddFermentableContext(ctx context.Context, beerID string, fermentableID int) error {
 	// POST: /beer/:beerId/fermentables
 	q := struct {
 		ID int `url:"fermentableId"`
 	}{fermentableID}
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/fermentables", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/fermentables", &q)
 	if err != nil {
 		return err
 	}
@@ -393,8 +492,13 @@ func (bs *BeerService) AddFermentable(beerID string, fermentableID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_fermentable#3
 func (bs *BeerService) DeleteFermentable(beerID string, fermentableID int) error {
+	return bs.DeleteFermentableContext(context.Background(), beerID, fermentableID)
+}
+
+// DeleteFermentableContext is like DeleteFermentable but uses the given Context for the request.
+func (bs *BeerService) DeleteFermentableContext(ctx context.Context, beerID string, fermentableID int) error {
 	// DELETE: /beer/:beerId/fermentable/:fermentableId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/beer/%s/fermentable/%d", beerID, fermentableID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/beer/%s/fermentable/%d", beerID, fermentableID), nil)
 	if err != nil {
 		return err
 	}
@@ -405,18 +509,19 @@ func (bs *BeerService) DeleteFermentable(beerID string, fermentableID int) error
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_hop#1
 func (bs *BeerService) ListHops(beerID string) (al []Hop, err error) {
+	return bs.ListHopsContext(context.Background(), beerID)
+}
+
+// ListHopsContext is like ListHops but uses the given Context for the request.
+func (bs *BeerService) ListHopsContext(ctx context.Context, beerID string) (al []Hop, err error) {
 	// GET: /beer/:beerId/hops
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/hops", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/hops", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Hop
-		Message string
-	}{}
+	var resp Envelope[[]Hop]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -425,11 +530,16 @@ func (bs *BeerService) ListHops(beerID string) (al []Hop, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_hop#2
 func (bs *BeerService) AddHop(beerID string, hopID int) error {
+	return bs.AddHopContext(context.Background(), beerID, hopID)
+}
+
+// AddHopContext is like AddHop but uses the given Context for the request.
+func (bs *BeerService) AddHopContext(ctx context.Context, beerID string, hopID int) error {
 	// POST: /beer/:beerId/hops
 	q := struct {
 		ID int `url:"hopId"`
 	}{hopID}
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/hops", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/hops", &q)
 	if err != nil {
 		return err
 	}
@@ -441,8 +551,13 @@ func (bs *BeerService) AddHop(beerID string, hopID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_hop#3
 func (bs *BeerService) DeleteHop(beerID string, hopID int) error {
+	return bs.DeleteHopContext(context.Background(), beerID, hopID)
+}
+
+// DeleteHopContext is like DeleteHop but uses the given Context for the request.
+func (bs *BeerService) DeleteHopContext(ctx context.Context, beerID string, hopID int) error {
 	// DELETE: /beer/:beerId/hop/:hopId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/beer/%s/hop/%d", beerID, hopID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/beer/%s/hop/%d", beerID, hopID), nil)
 	if err != nil {
 		return err
 	}
@@ -453,75 +568,78 @@ func (bs *BeerService) DeleteHop(beerID string, hopID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_ingredient#1
 func (bs *BeerService) ListIngredients(beerID string) (el []Ingredient, err error) {
+	return bs.ListIngredientsContext(context.Background(), beerID)
+}
+
+// ListIngredientsContext is like ListIngredients but uses the given Context for the request.
+func (bs *BeerService) ListIngredientsContext(ctx context.Context, beerID string) (el []Ingredient, err error) {
 	// GET: /beer/:beerId/ingredients
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/ingredients", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/ingredients", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Ingredient
-		Message string
-	}{}
+	var resp Envelope[[]Ingredient]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // RandomBeerRequest contains options for retrieving a random Beer.
 type RandomBeerRequest struct {
-	ABV                string `url:"abv,omitempty"`
-	IBU                string `url:"ibu,omitempty"`
-	GlasswareID        int    `url:"glasswareId,omitempty"`
-	SrmID              int    `url:"srmID,omitempty"`
-	AvailableID        int    `url:"availableId,omitempty"`
-	StyleID            int    `url:"styleId,omitempty"`
-	IsOrganic          YesNo  `url:"isOrganic,omitempty"`
-	Labels             YesNo  `url:"labels,omitempty"`
-	Year               int    `url:"year,omitempty"`
-	WithBreweries      YesNo  `url:"withBreweries,omitempty"`
-	WithSocialAccounts YesNo  `url:"withSocialAccounts,omitempty"`
-	WithIngredients    YesNo  `url:"withIngredients,omitempty"`
+	ABV                Range `url:"abv,omitempty"`
+	IBU                Range `url:"ibu,omitempty"`
+	GlasswareID        int   `url:"glasswareId,omitempty"`
+	SrmID              int   `url:"srmID,omitempty"`
+	AvailableID        int   `url:"availableId,omitempty"`
+	StyleID            int   `url:"styleId,omitempty"`
+	IsOrganic          YesNo `url:"isOrganic,omitempty"`
+	Labels             YesNo `url:"labels,omitempty"`
+	Year               Range `url:"year,omitempty"`
+	WithBreweries      YesNo `url:"withBreweries,omitempty"`
+	WithSocialAccounts YesNo `url:"withSocialAccounts,omitempty"`
+	WithIngredients    YesNo `url:"withIngredients,omitempty"`
 }
 
 // GetRandom returns a random Beer.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_random#1
 func (bs *BeerService) GetRandom(q *RandomBeerRequest) (b Beer, err error) {
+	return bs.GetRandomContext(context.Background(), q)
+}
+
+// GetRandomContext is like GetRandom but uses the given Context for the request.
+func (bs *BeerService) GetRandomContext(ctx context.Context, q *RandomBeerRequest) (b Beer, err error) {
 	// GET: /beer/random
 
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/random", q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/random", q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Beer
-		Message string
-	}{}
+	var resp Envelope[Beer]
 	err = bs.c.Do(req, &resp)
-	return resp.Data, nil
+	return resp.Data, err
 }
 
 // ListSocialAccounts returns a slice of all social media accounts associated with the given Beer.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_socialaccount#1
 func (bs *BeerService) ListSocialAccounts(beerID string) (sl []SocialAccount, err error) {
+	return bs.ListSocialAccountsContext(context.Background(), beerID)
+}
+
+// ListSocialAccountsContext is like ListSocialAccounts but uses the given Context for the request.
+func (bs *BeerService) ListSocialAccountsContext(ctx context.Context, beerID string) (sl []SocialAccount, err error) {
 	// GET: /beer/:beerId/socialaccounts
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/socialaccounts", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/socialaccounts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []SocialAccount
-		Message string
-	}{}
+	var resp Envelope[[]SocialAccount]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -530,18 +648,19 @@ func (bs *BeerService) ListSocialAccounts(beerID string) (sl []SocialAccount, er
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_socialaccount#2
 func (bs *BeerService) GetSocialAccount(beerID string, socialAccountID int) (s SocialAccount, err error) {
+	return bs.GetSocialAccountContext(context.Background(), beerID, socialAccountID)
+}
+
+// GetSocialAccountContext is like GetSocialAccount but uses the given Context for the request.
+func (bs *BeerService) GetSocialAccountContext(ctx context.Context, beerID string, socialAccountID int) (s SocialAccount, err error) {
 	// GET: /beer/:beerId/socialaccount/:socialaccountId
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, socialAccountID), nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, socialAccountID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    SocialAccount
-		Message string
-	}{}
+	var resp Envelope[SocialAccount]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -550,11 +669,16 @@ func (bs *BeerService) GetSocialAccount(beerID string, socialAccountID int) (s S
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_socialaccount#3
 func (bs *BeerService) AddSocialAccount(beerID string, s *SocialAccount) error {
+	return bs.AddSocialAccountContext(context.Background(), beerID, s)
+}
+
+// AddSocialAccountContext is like AddSocialAccount but uses the given Context for the request.
+func (bs *BeerService) AddSocialAccountContext(ctx context.Context, beerID string, s *SocialAccount) error {
 	// POST: /beer/:beerId/socialaccounts
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/socialaccounts", s)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/socialaccounts", s)
 	if err != nil {
 		return err
 	}
@@ -566,11 +690,16 @@ func (bs *BeerService) AddSocialAccount(beerID string, s *SocialAccount) error {
 //
 // See: TODO (API docs seem wrong)
 func (bs *BeerService) UpdateSocialAccount(beerID string, s *SocialAccount) error {
+	return bs.UpdateSocialAccountContext(context.Background(), beerID, s)
+}
+
+// UpdateSocialAccountContext is like UpdateSocialAccount but uses the given Context for the request.
+func (bs *BeerService) UpdateSocialAccountContext(ctx context.Context, beerID string, s *SocialAccount) error {
 	// PUT: /beer/:beerId/socialaccount/:socialaccountId
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := bs.c.NewRequest("PUT", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, s.ID), s)
+	req, err := bs.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, s.ID), s)
 	if err != nil {
 		return err
 	}
@@ -582,8 +711,13 @@ func (bs *BeerService) UpdateSocialAccount(beerID string, s *SocialAccount) erro
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_socialaccount#4
 func (bs *BeerService) DeleteSocialAccount(beerID string, socialAccountID int) error {
+	return bs.DeleteSocialAccountContext(context.Background(), beerID, socialAccountID)
+}
+
+// DeleteSocialAccountContext is like DeleteSocialAccount but uses the given Context for the request.
+func (bs *BeerService) DeleteSocialAccountContext(ctx context.Context, beerID string, socialAccountID int) error {
 	// DELETE: /beer/:beerId/socialaccount/:socialaccountId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, socialAccountID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/beer/%s/socialaccount/%d", beerID, socialAccountID), nil)
 	if err != nil {
 		return err
 	}
@@ -596,6 +730,11 @@ func (bs *BeerService) DeleteSocialAccount(beerID string, socialAccountID int) e
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_upc#1
 func (bs *BeerService) AddUPC(beerID string, code uint64, fluidsizeID *int) error {
+	return bs.AddUPCContext(context.Background(), beerID, code, fluidsizeID)
+}
+
+// AddUPCContext is like AddUPC but uses the given Context for the request.
+func (bs *BeerService) AddUPCContext(ctx context.Context, beerID string, code uint64, fluidsizeID *int) error {
 	// POST: /beer/:beerId/upcs
 	q := struct {
 		Code        uint64 `url:"upcCode"`
@@ -606,7 +745,7 @@ func (bs *BeerService) AddUPC(beerID string, code uint64, fluidsizeID *int) erro
 		q.FluidsizeID = *fluidsizeID
 	}
 
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/upcs", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/upcs", &q)
 	if err != nil {
 		return err
 	}
@@ -618,18 +757,19 @@ func (bs *BeerService) AddUPC(beerID string, code uint64, fluidsizeID *int) erro
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_variation#1
 func (bs *BeerService) ListVariations(beerID string) (bl []Beer, err error) {
+	return bs.ListVariationsContext(context.Background(), beerID)
+}
+
+// ListVariationsContext is like ListVariations but uses the given Context for the request.
+func (bs *BeerService) ListVariationsContext(ctx context.Context, beerID string) (bl []Beer, err error) {
 	// GET: /beer/:beerId/variations
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/variations", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/variations", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Beer
-		Message string
-	}{}
+	var resp Envelope[[]Beer]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -638,18 +778,19 @@ func (bs *BeerService) ListVariations(beerID string) (bl []Beer, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_yeast#1
 func (bs *BeerService) ListYeasts(beerID string) (al []Yeast, err error) {
+	return bs.ListYeastsContext(context.Background(), beerID)
+}
+
+// ListYeastsContext is like ListYeasts but uses the given Context for the request.
+func (bs *BeerService) ListYeastsContext(ctx context.Context, beerID string) (al []Yeast, err error) {
 	// GET: /beer/:beerId/yeasts
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/beer/"+beerID+"/yeasts", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/beer/"+beerID+"/yeasts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Yeast
-		Message string
-	}{}
+	var resp Envelope[[]Yeast]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -658,11 +799,16 @@ func (bs *BeerService) ListYeasts(beerID string) (al []Yeast, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_yeast#2
 func (bs *BeerService) AddYeast(beerID string, yeastID int) error {
+	return bs.AddYeastContext(context.Background(), beerID, yeastID)
+}
+
+// AddYeastContext is like AddYeast but uses the given Context for the request.
+func (bs *BeerService) AddYeastContext(ctx context.Context, beerID string, yeastID int) error {
 	// POST: /beer/:beerId/yeasts
 	q := struct {
 		ID int `url:"yeastId"`
 	}{yeastID}
-	req, err := bs.c.NewRequest("POST", "/beer/"+beerID+"/yeasts", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/beer/"+beerID+"/yeasts", &q)
 	if err != nil {
 		return err
 	}
@@ -674,8 +820,13 @@ func (bs *BeerService) AddYeast(beerID string, yeastID int) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/beer_yeast#3
 func (bs *BeerService) DeleteYeast(beerID string, yeastID int) error {
+	return bs.DeleteYeastContext(context.Background(), beerID, yeastID)
+}
+
+// DeleteYeastContext is like DeleteYeast but uses the given Context for the request.
+func (bs *BeerService) DeleteYeastContext(ctx context.Context, beerID string, yeastID int) error {
 	// DELETE: /beer/:beerId/yeast/:yeastId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/beer/%s/yeast/%d", beerID, yeastID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/beer/%s/yeast/%d", beerID, yeastID), nil)
 	if err != nil {
 		return err
 	}
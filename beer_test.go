@@ -45,11 +45,11 @@ func TestBeerList(t *testing.T) {
 	data := loadTestData("beer.list.json", t)
 	defer data.Close()
 
-	const abv = "8"
+	abv := Between(8, 9.5)
 	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
 		checkMethod(t, r, "GET")
-		if v := r.FormValue("abv"); v != abv {
-			t.Fatalf("Request.FormValue abv = %v, wanted %v", v, abv)
+		if v := r.FormValue("abv"); v != "8,9.5" {
+			t.Fatalf("Request.FormValue abv = %v, wanted %v", v, "8,9.5")
 		}
 		// TODO: check more request query values
 		io.Copy(w, data)
@@ -59,10 +59,10 @@ func TestBeerList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Beers) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 beers")
 	}
-	for _, b := range bl.Beers {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Beer ID len = %d, wanted %d", len(b.ID), l)
 		}
@@ -80,9 +80,9 @@ func makeTestBeer() *Beer {
 		Name:            "The Truth",
 		Description:     "Hop bomb",
 		FoodPairings:    "Barbecue",
-		OriginalGravity: "1.0",
-		ABV:             "8.7",
-		IBU:             "80",
+		OriginalGravity: NewNumber(1.0),
+		ABV:             NewNumber(8.7),
+		IBU:             NewNumber(80),
 		GlasswareID:     5,
 		Glass:           Glass{ID: 5, Name: "Pint"},
 		StyleID:         31,
@@ -122,9 +122,9 @@ func TestBeerAdd(t *testing.T) {
 		checkPostFormValue(t, r, "name", beer.Name)
 		checkPostFormValue(t, r, "description", beer.Description)
 		checkPostFormValue(t, r, "foodPairings", beer.FoodPairings)
-		checkPostFormValue(t, r, "originalGravity", beer.OriginalGravity)
-		checkPostFormValue(t, r, "abv", beer.ABV)
-		checkPostFormValue(t, r, "ibu", beer.IBU)
+		checkPostFormValue(t, r, "originalGravity", beer.OriginalGravity.String())
+		checkPostFormValue(t, r, "abv", beer.ABV.String())
+		checkPostFormValue(t, r, "ibu", beer.IBU.String())
 		checkPostFormValue(t, r, "glasswareId", strconv.Itoa(beer.GlasswareID))
 		checkPostFormValue(t, r, "styleId", strconv.Itoa(beer.StyleID))
 		checkPostFormValue(t, r, "isOrganic", "Y")
@@ -176,9 +176,9 @@ func TestBeerUpdate(t *testing.T) {
 		checkPostFormValue(t, r, "name", beer.Name)
 		checkPostFormValue(t, r, "description", beer.Description)
 		checkPostFormValue(t, r, "foodPairings", beer.FoodPairings)
-		checkPostFormValue(t, r, "originalGravity", beer.OriginalGravity)
-		checkPostFormValue(t, r, "abv", beer.ABV)
-		checkPostFormValue(t, r, "ibu", beer.IBU)
+		checkPostFormValue(t, r, "originalGravity", beer.OriginalGravity.String())
+		checkPostFormValue(t, r, "abv", beer.ABV.String())
+		checkPostFormValue(t, r, "ibu", beer.IBU.String())
 		checkPostFormValue(t, r, "glasswareId", strconv.Itoa(beer.GlasswareID))
 		checkPostFormValue(t, r, "styleId", strconv.Itoa(beer.StyleID))
 		checkPostFormValue(t, r, "isOrganic", "Y")
@@ -298,7 +298,7 @@ func TestBeerDeleteBrewery(t *testing.T) {
 	})
 }
 
-type beerDeleter func(string, int) error
+type beerDeleter func(*BeerService, string, int) error
 
 func testBeerDeleteHelper(t *testing.T, name string, otherID int, del beerDeleter) {
 	setup()
@@ -319,46 +319,46 @@ func testBeerDeleteHelper(t *testing.T, name string, otherID int, del beerDelete
 		}
 	})
 
-	if err := del(beerID, otherID); err != nil {
+	if err := del(client.Beer, beerID, otherID); err != nil {
 		panic(err)
 	}
 
-	if del(beerID, -1) == nil {
+	if del(client.Beer, beerID, -1) == nil {
 		t.Fatal("expected HTTP 404 error")
 	}
 
-	if del("*~*~*~", otherID) == nil {
+	if del(client.Beer, "*~*~*~", otherID) == nil {
 		t.Fatal("expected HTTP 404 error")
 	}
 
 	testBadURL(t, func() error {
-		return del(beerID, otherID)
+		return del(client.Beer, beerID, otherID)
 	})
 }
 
 func TestBeerDeleteAdjunct(t *testing.T) {
 	adjunctID := 923
-	testBeerDeleteHelper(t, "adjunct", adjunctID, client.Beer.DeleteAdjunct)
+	testBeerDeleteHelper(t, "adjunct", adjunctID, (*BeerService).DeleteAdjunct)
 }
 
 func TestBeerDeleteFermentable(t *testing.T) {
 	fermentableID := 753
-	testBeerDeleteHelper(t, "fermentable", fermentableID, client.Beer.DeleteFermentable)
+	testBeerDeleteHelper(t, "fermentable", fermentableID, (*BeerService).DeleteFermentable)
 }
 
 func TestBeerDeleteHop(t *testing.T) {
 	hopID := 42
-	testBeerDeleteHelper(t, "hop", hopID, client.Beer.DeleteHop)
+	testBeerDeleteHelper(t, "hop", hopID, (*BeerService).DeleteHop)
 }
 
 func TestBeerDeleteSocialAccount(t *testing.T) {
 	socialID := 3
-	testBeerDeleteHelper(t, "socialaccount", socialID, client.Beer.DeleteSocialAccount)
+	testBeerDeleteHelper(t, "socialaccount", socialID, (*BeerService).DeleteSocialAccount)
 }
 
 func TestBeerDeleteYeast(t *testing.T) {
 	yeastID := 1835
-	testBeerDeleteHelper(t, "yeast", yeastID, client.Beer.DeleteYeast)
+	testBeerDeleteHelper(t, "yeast", yeastID, (*BeerService).DeleteYeast)
 }
 
 func TestBeerListAdjuncts(t *testing.T) {
@@ -613,7 +613,7 @@ func TestBeerListYeasts(t *testing.T) {
 	})
 }
 
-type beerAdder func(string, int) error
+type beerAdder func(*BeerService, string, int) error
 
 func testBeerAddHelper(t *testing.T, name string, otherID int, add beerAdder) {
 	setup()
@@ -633,22 +633,22 @@ func testBeerAddHelper(t *testing.T, name string, otherID int, add beerAdder) {
 		checkPostFormValue(t, r, name+"Id", strconv.Itoa(otherID))
 	})
 
-	if err := add(beerID, otherID); err != nil {
+	if err := add(client.Beer, beerID, otherID); err != nil {
 		t.Fatal(err)
 	}
 
-	if add("******", otherID) == nil {
+	if add(client.Beer, "******", otherID) == nil {
 		t.Fatal("expected HTTP error")
 	}
 
 	testBadURL(t, func() error {
-		return add(beerID, otherID)
+		return add(client.Beer, beerID, otherID)
 	})
 }
 
 func TestBeerAddAdjunct(t *testing.T) {
 	const adjunctID = 923
-	testBeerAddHelper(t, "adjunct", adjunctID, client.Beer.AddAdjunct)
+	testBeerAddHelper(t, "adjunct", adjunctID, (*BeerService).AddAdjunct)
 }
 
 func TestBeerListBreweries(t *testing.T) {
@@ -745,12 +745,12 @@ func TestBeerAddBrewery(t *testing.T) {
 
 func TestBeerAddFermentable(t *testing.T) {
 	const fermentableID = 753
-	testBeerAddHelper(t, "fermentable", fermentableID, client.Beer.AddFermentable)
+	testBeerAddHelper(t, "fermentable", fermentableID, (*BeerService).AddFermentable)
 }
 
 func TestBeerAddHop(t *testing.T) {
 	const hopID = 42
-	testBeerAddHelper(t, "hop", hopID, client.Beer.AddHop)
+	testBeerAddHelper(t, "hop", hopID, (*BeerService).AddHop)
 }
 
 func TestBeerGetSocialAccount(t *testing.T) {
@@ -992,14 +992,14 @@ func TestBeerAddUPC(t *testing.T) {
 
 func TestBeerAddYeast(t *testing.T) {
 	const yeastID = 1835
-	testBeerAddHelper(t, "yeast", yeastID, client.Beer.AddYeast)
+	testBeerAddHelper(t, "yeast", yeastID, (*BeerService).AddYeast)
 }
 
 func TestBeerGetRandom(t *testing.T) {
 	setup()
 	defer teardown()
 
-	data, err := os.Open("test_data/beer.get.random.json")
+	data, err := os.Open("brewerydbtest/fixtures/beer.get.random.json")
 	if err != nil {
 		t.Fatal("Failed to open test data file")
 	}
@@ -1014,7 +1014,7 @@ func TestBeerGetRandom(t *testing.T) {
 		io.Copy(w, data)
 	})
 
-	b, err := client.Beer.GetRandom(&RandomBeerRequest{ABV: "8"})
+	b, err := client.Beer.GetRandom(&RandomBeerRequest{ABV: Exactly(8)})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1028,7 +1028,7 @@ func TestBeerGetRandom(t *testing.T) {
 	}
 
 	testBadURL(t, func() error {
-		_, err := client.Beer.GetRandom(&RandomBeerRequest{ABV: "8"})
+		_, err := client.Beer.GetRandom(&RandomBeerRequest{ABV: Exactly(8)})
 		return err
 	})
 }
@@ -1082,17 +1082,17 @@ func TestBeerListEvents(t *testing.T) {
 func ExampleBeerService_List() {
 	c := NewClient(os.Getenv("BREWERYDB_API_KEY"))
 
-	bl, err := c.Beer.List(&BeerListRequest{ABV: "8", Sort: SortDescending})
+	bl, err := c.Beer.List(&BeerListRequest{ABV: Exactly(8), Sort: SortDescending})
 	if err != nil {
 		panic(err)
 	}
-	for _, b := range bl.Beers {
+	for _, b := range bl.Data {
 		fmt.Println(b.Name, b.ID)
 	}
 }
 
 // List breweries for a given beer
-func ExampleBeerService_Breweries() {
+func ExampleBeerService_ListBreweries() {
 	c := NewClient(os.Getenv("BREWERYDB_API_KEY"))
 
 	breweries, err := c.Beer.ListBreweries("jmGoBA")
@@ -1105,11 +1105,11 @@ func ExampleBeerService_Breweries() {
 }
 
 // Get a random beer with an ABV between 8.0 and 9.0
-func ExampleBeerService_Random() {
+func ExampleBeerService_GetRandom() {
 	c := NewClient(os.Getenv("BREWERYDB_API_KEY"))
 
 	req := &RandomBeerRequest{
-		ABV: "8",
+		ABV: Exactly(8),
 	}
 	b, err := c.Beer.GetRandom(req)
 	if err != nil {
@@ -1,8 +1,11 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // BreweryService provides access to the BreweryDB Brewery API. Use Client.Brewery.
@@ -28,28 +31,23 @@ const (
 )
 
 // BreweryList represents a "page" containing one slice of Breweries.
-type BreweryList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Breweries     []Brewery `json:"data"`
-}
+type BreweryList = Page[Brewery]
 
 // Brewery contains all relevant information for a single Brewery.
 type Brewery struct {
-	ID             string `url:"-"`
-	Name           string `url:"name"`
-	Description    string `url:"description,omitempty"`
-	MailingListURL string `url:"mailingListUrl,omitempty"`
-	Images         Images `url:"-"`
-	Image          string `url:"image,omitempty"` // only used for adding/update Breweries
-	Established    string `url:"established,omitempty"`
-	IsOrganic      YesNo  `url:"isOrganic,omitempty"`
-	Website        string `url:"website,omitempty"`
-	Status         string `url:"-"`
-	StatusDisplay  string `url:"-"`
-	CreateDate     string `url:"-"`
-	UpdateDate     string `url:"-"`
+	ID             string    `url:"-"`
+	Name           string    `url:"name"`
+	Description    string    `url:"description,omitempty"`
+	MailingListURL string    `url:"mailingListUrl,omitempty"`
+	Images         Images    `url:"-"`
+	Image          string    `url:"image,omitempty"` // only used for adding/update Breweries
+	Established    string    `url:"established,omitempty"`
+	IsOrganic      YesNo     `url:"isOrganic,omitempty"`
+	Website        string    `url:"website,omitempty"`
+	Status         string    `url:"-"`
+	StatusDisplay  string    `url:"-"`
+	CreateDate     Timestamp `url:"-"`
+	UpdateDate     Timestamp `url:"-"`
 }
 
 // BreweryListRequest contains all the required and optional fields
@@ -61,7 +59,7 @@ type BreweryListRequest struct {
 	Established        string       `url:"established,omitempty"`
 	IsOrganic          YesNo        `url:"isOrganic,omitempty"`
 	HasImages          YesNo        `url:"hasImages,omitempty"`
-	Since              string       `url:"since,omitempty"`
+	Since              time.Time    `url:"since,omitempty,unix"` // Max 30 days ago
 	Status             string       `url:"status,omitempty"`
 	Order              BreweryOrder `url:"order,omitempty"` // TODO: enumerate
 	Sort               string       `url:"sort,omitempty"`  // TODO: enumerate
@@ -75,9 +73,14 @@ type BreweryListRequest struct {
 // List returns all Breweries on the page specified in the given BreweryListRequest.
 // For non-premium members, one of Name or Established must be set.
 func (bs *BreweryService) List(q *BreweryListRequest) (bl BreweryList, err error) {
+	return bs.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (bs *BreweryService) ListContext(ctx context.Context, q *BreweryListRequest) (bl BreweryList, err error) {
 	// GET: /breweries
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/breweries", q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/breweries", q)
 	if err != nil {
 		return
 	}
@@ -86,72 +89,123 @@ func (bs *BreweryService) List(q *BreweryListRequest) (bl BreweryList, err error
 	return
 }
 
+// All returns an iterator over the Breweries on every page matching the
+// given BreweryListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (bs *BreweryService) All(q *BreweryListRequest) iter.Seq2[Brewery, error] {
+	return bs.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (bs *BreweryService) AllContext(ctx context.Context, q *BreweryListRequest) iter.Seq2[Brewery, error] {
+	var req BreweryListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Brewery, int, error) {
+		r := req
+		r.Page = page
+		l, err := bs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Breweries on every page matching the given
+// BreweryListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (bs *BreweryService) FetchAll(q *BreweryListRequest, workers int) ([]Brewery, error) {
+	return bs.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (bs *BreweryService) FetchAllContext(ctx context.Context, q *BreweryListRequest, workers int) ([]Brewery, error) {
+	var req BreweryListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Brewery, int, error) {
+		r := req
+		r.Page = page
+		l, err := bs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get queries for a single Brewery with the given Brewery ID.
 func (bs *BreweryService) Get(id string) (brewery Brewery, err error) {
+	return bs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (bs *BreweryService) GetContext(ctx context.Context, id string) (brewery Brewery, err error) {
 	// GET: /brewery/:breweryId
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+id, nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+id, nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Message string
-		Data    Brewery
-		Status  string
-	}{}
+	var resp Envelope[Brewery]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // Add adds a new Brewery to the BreweryDB and returns its new ID.
 func (bs *BreweryService) Add(b *Brewery) (id string, err error) {
+	return bs.AddContext(context.Background(), b)
+}
+
+// AddContext is like Add but uses the given Context for the request.
+func (bs *BreweryService) AddContext(ctx context.Context, b *Brewery) (id string, err error) {
 	// POST: /breweries
 	if b == nil {
 		err = fmt.Errorf("nil Brewery")
 		return
 	}
 	var req *http.Request
-	req, err = bs.c.NewRequest("POST", "/breweries", b)
+	req, err = bs.c.NewRequestWithContext(ctx, "POST", "/breweries", b)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID string
-		}
-		Message string
-	}{}
+	var resp Envelope[struct{ ID string }]
 	err = bs.c.Do(req, &resp)
 	return resp.Data.ID, err
 }
 
 // Update changes an existing Brewery in the BreweryDB.
 func (bs *BreweryService) Update(breweryID string, b *Brewery) error {
+	return bs.UpdateContext(context.Background(), breweryID, b)
+}
+
+// UpdateContext is like Update but uses the given Context for the request.
+func (bs *BreweryService) UpdateContext(ctx context.Context, breweryID string, b *Brewery) error {
 	// PUT: /brewery/:breweryId
 	if b == nil {
 		return fmt.Errorf("nil Brewery")
 	}
-	req, err := bs.c.NewRequest("PUT", "/brewery/"+breweryID, b)
+	req, err := bs.c.NewRequestWithContext(ctx, "PUT", "/brewery/"+breweryID, b)
 	if err != nil {
 		return err
 	}
 
-	// TODO: extract and return response message?
 	return bs.c.Do(req, nil)
 }
 
 // Delete removes the Brewery with the given ID from the BreweryDB.
 func (bs *BreweryService) Delete(id string) error {
+	return bs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given Context for the request.
+func (bs *BreweryService) DeleteContext(ctx context.Context, id string) error {
 	// DELETE: /brewery/:breweryId
-	req, err := bs.c.NewRequest("DELETE", "/brewery/"+id, nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", "/brewery/"+id, nil)
 	if err != nil {
 		return err
 	}
 
-	// TODO: extract and return response message?
 	return bs.c.Do(req, nil)
 }
 
@@ -162,24 +216,25 @@ type AlternateName struct {
 	ID         int
 	Name       string
 	BreweryID  string
-	CreateDate string
-	UpdateDate string
+	CreateDate Timestamp
+	UpdateDate Timestamp
 }
 
 // ListAlternateNames returns a slice of all the AlternateNames for the Brewery with the given ID.
 func (bs *BreweryService) ListAlternateNames(breweryID string) (al []AlternateName, err error) {
+	return bs.ListAlternateNamesContext(context.Background(), breweryID)
+}
+
+// ListAlternateNamesContext is like ListAlternateNames but uses the given Context for the request.
+func (bs *BreweryService) ListAlternateNamesContext(ctx context.Context, breweryID string) (al []AlternateName, err error) {
 	// GET: /brewery/:breweryId/alternatenames
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/alternatenames", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/alternatenames", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []AlternateName
-		Message string
-	}{}
+	var resp Envelope[[]AlternateName]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -187,31 +242,35 @@ func (bs *BreweryService) ListAlternateNames(breweryID string) (al []AlternateNa
 // AddAlternateName adds an alternate name to the Brewery with the given ID
 // and returns the alternate name's new ID.
 func (bs *BreweryService) AddAlternateName(breweryID, name string) (id int, err error) {
+	return bs.AddAlternateNameContext(context.Background(), breweryID, name)
+}
+
+// AddAlternateNameContext is like AddAlternateName but uses the given Context for the request.
+func (bs *BreweryService) AddAlternateNameContext(ctx context.Context, breweryID, name string) (id int, err error) {
 	// POST: /brewery/:breweryId/alternatenames
 	q := struct {
 		Name string `url:"name"`
 	}{name}
 	var req *http.Request
-	req, err = bs.c.NewRequest("POST", "/brewery/"+breweryID+"/alternatenames", &q)
+	req, err = bs.c.NewRequestWithContext(ctx, "POST", "/brewery/"+breweryID+"/alternatenames", &q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID int
-		}
-		Message string
-	}{}
+	var resp Envelope[struct{ ID int }]
 	err = bs.c.Do(req, &resp)
 	return resp.Data.ID, err
 }
 
 // DeleteAlternateName removes the AlternateName with the given ID from the Brewery with the given ID.
 func (bs *BreweryService) DeleteAlternateName(breweryID string, alternateNameID int) error {
+	return bs.DeleteAlternateNameContext(context.Background(), breweryID, alternateNameID)
+}
+
+// DeleteAlternateNameContext is like DeleteAlternateName but uses the given Context for the request.
+func (bs *BreweryService) DeleteAlternateNameContext(ctx context.Context, breweryID string, alternateNameID int) error {
 	// DELETE: /brewery/:breweryId/alternatename/:alternatenameId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/brewery/%s/alternatename/%d", breweryID, alternateNameID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/brewery/%s/alternatename/%d", breweryID, alternateNameID), nil)
 	if err != nil {
 		return err
 	}
@@ -227,18 +286,19 @@ type BreweryBeersRequest struct {
 
 // ListBeers returns a slice of all Beers offered by the Brewery with the given ID.
 func (bs *BreweryService) ListBeers(breweryID string, q *BreweryBeersRequest) (bl []Beer, err error) {
+	return bs.ListBeersContext(context.Background(), breweryID, q)
+}
+
+// ListBeersContext is like ListBeers but uses the given Context for the request.
+func (bs *BreweryService) ListBeersContext(ctx context.Context, breweryID string, q *BreweryBeersRequest) (bl []Beer, err error) {
 	// GET: /brewery/:breweryId/beers
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/beers", q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/beers", q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Beer
-		Message string
-	}{}
+	var resp Envelope[[]Beer]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -246,40 +306,42 @@ func (bs *BreweryService) ListBeers(breweryID string, q *BreweryBeersRequest) (b
 // ListEvents returns a slice of Events where the given Brewery is/was present
 // or has won awards.
 func (bs *BreweryService) ListEvents(breweryID string, onlyWinners bool) (el []Event, err error) {
+	return bs.ListEventsContext(context.Background(), breweryID, onlyWinners)
+}
+
+// ListEventsContext is like ListEvents but uses the given Context for the request.
+func (bs *BreweryService) ListEventsContext(ctx context.Context, breweryID string, onlyWinners bool) (el []Event, err error) {
 	// GET: /brewery/:breweryId/events
 	q := struct {
 		OnlyWinners YesNo `url:"onlyWinners,omitempty"`
 	}{YesNo(onlyWinners)}
 
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/events", &q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/events", &q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Event
-		Message string
-	}{}
+	var resp Envelope[[]Event]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // ListGuilds returns a slice of all Guilds the Brewery with the given ID belongs to.
 func (bs *BreweryService) ListGuilds(breweryID string) (al []Guild, err error) {
+	return bs.ListGuildsContext(context.Background(), breweryID)
+}
+
+// ListGuildsContext is like ListGuilds but uses the given Context for the request.
+func (bs *BreweryService) ListGuildsContext(ctx context.Context, breweryID string) (al []Guild, err error) {
 	// GET: /brewery/:breweryId/guilds
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/guilds", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/guilds", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Guild
-		Message string
-	}{}
+	var resp Envelope[[]Guild]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -287,6 +349,11 @@ func (bs *BreweryService) ListGuilds(breweryID string) (al []Guild, err error) {
 // AddGuild adds the Guild with the given ID to the Brewery with the given ID.
 // discount is optional (value of discount offered to guild members).
 func (bs *BreweryService) AddGuild(breweryID string, guildID string, discount *string) error {
+	return bs.AddGuildContext(context.Background(), breweryID, guildID, discount)
+}
+
+// AddGuildContext is like AddGuild but uses the given Context for the request.
+func (bs *BreweryService) AddGuildContext(ctx context.Context, breweryID string, guildID string, discount *string) error {
 	// POST: /brewery/:breweryId/guilds
 	q := struct {
 		ID       string `url:"guildId"`
@@ -296,7 +363,7 @@ func (bs *BreweryService) AddGuild(breweryID string, guildID string, discount *s
 		q.Discount = *discount
 	}
 
-	req, err := bs.c.NewRequest("POST", "/brewery/"+breweryID+"/guilds", &q)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/brewery/"+breweryID+"/guilds", &q)
 	if err != nil {
 		return err
 	}
@@ -306,8 +373,13 @@ func (bs *BreweryService) AddGuild(breweryID string, guildID string, discount *s
 
 // DeleteGuild removes the Guild with the given ID from the Brewery with the given ID.
 func (bs *BreweryService) DeleteGuild(breweryID string, guildID string) error {
+	return bs.DeleteGuildContext(context.Background(), breweryID, guildID)
+}
+
+// DeleteGuildContext is like DeleteGuild but uses the given Context for the request.
+func (bs *BreweryService) DeleteGuildContext(ctx context.Context, breweryID string, guildID string) error {
 	// DELETE: /brewery/:breweryId/guild/:guildId
-	req, err := bs.c.NewRequest("DELETE", "/brewery/"+breweryID+"/guild/"+guildID, nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", "/brewery/"+breweryID+"/guild/"+guildID, nil)
 	if err != nil {
 		return err
 	}
@@ -316,18 +388,19 @@ func (bs *BreweryService) DeleteGuild(breweryID string, guildID string) error {
 
 // ListLocations returns a slice of all locations for the Brewery with the given ID.
 func (bs *BreweryService) ListLocations(breweryID string) (ll []Location, err error) {
+	return bs.ListLocationsContext(context.Background(), breweryID)
+}
+
+// ListLocationsContext is like ListLocations but uses the given Context for the request.
+func (bs *BreweryService) ListLocationsContext(ctx context.Context, breweryID string) (ll []Location, err error) {
 	// GET: /brewery/:breweryId/locations
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/locations", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/locations", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Location
-		Message string
-	}{}
+	var resp Envelope[[]Location]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -337,23 +410,24 @@ func (bs *BreweryService) ListLocations(breweryID string) (ll []Location, err er
 // TODO: verify that the location ID in the response is, in fact, called "guid".
 // see: http://www.brewerydb.com/developers/docs-endpoint/brewery_location#2
 func (bs *BreweryService) AddLocation(breweryID string, loc *Location) (id string, err error) {
+	return bs.AddLocationContext(context.Background(), breweryID, loc)
+}
+
+// AddLocationContext is like AddLocation but uses the given Context for the request.
+func (bs *BreweryService) AddLocationContext(ctx context.Context, breweryID string, loc *Location) (id string, err error) {
 	// POST: /brewery/:breweryId/locations
 	if loc == nil {
 		return "", fmt.Errorf("nil Location")
 	}
 	var req *http.Request
-	req, err = bs.c.NewRequest("POST", "/brewery/"+breweryID+"/locations", loc)
+	req, err = bs.c.NewRequestWithContext(ctx, "POST", "/brewery/"+breweryID+"/locations", loc)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID string `json:"guid"`
-		}
-		Message string
-	}{}
+	var resp Envelope[struct {
+		ID string `json:"guid"`
+	}]
 	err = bs.c.Do(req, &resp)
 	return resp.Data.ID, err
 }
@@ -370,65 +444,73 @@ type RandomBreweryRequest struct {
 
 // GetRandom returns a random active Brewery.
 func (bs *BreweryService) GetRandom(q *RandomBreweryRequest) (b Brewery, err error) {
+	return bs.GetRandomContext(context.Background(), q)
+}
+
+// GetRandomContext is like GetRandom but uses the given Context for the request.
+func (bs *BreweryService) GetRandomContext(ctx context.Context, q *RandomBreweryRequest) (b Brewery, err error) {
 	// GET: /brewery/random
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/random", q)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/random", q)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Brewery
-		Message string
-	}{}
+	var resp Envelope[Brewery]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // ListSocialAccounts returns a slice of all social media accounts associated with the given Brewery.
 func (bs *BreweryService) ListSocialAccounts(breweryID string) (sl []SocialAccount, err error) {
+	return bs.ListSocialAccountsContext(context.Background(), breweryID)
+}
+
+// ListSocialAccountsContext is like ListSocialAccounts but uses the given Context for the request.
+func (bs *BreweryService) ListSocialAccountsContext(ctx context.Context, breweryID string) (sl []SocialAccount, err error) {
 	// GET: /brewery/:breweryId/socialaccounts
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", "/brewery/"+breweryID+"/socialaccounts", nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", "/brewery/"+breweryID+"/socialaccounts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []SocialAccount
-		Message string
-	}{}
+	var resp Envelope[[]SocialAccount]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // GetSocialAccount retrieves the SocialAccount with the given ID for the given Brewery.
 func (bs *BreweryService) GetSocialAccount(breweryID string, socialAccountID int) (s SocialAccount, err error) {
+	return bs.GetSocialAccountContext(context.Background(), breweryID, socialAccountID)
+}
+
+// GetSocialAccountContext is like GetSocialAccount but uses the given Context for the request.
+func (bs *BreweryService) GetSocialAccountContext(ctx context.Context, breweryID string, socialAccountID int) (s SocialAccount, err error) {
 	// GET: /brewery/:breweryId/socialaccount/:socialaccountId
 	var req *http.Request
-	req, err = bs.c.NewRequest("GET", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, socialAccountID), nil)
+	req, err = bs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, socialAccountID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    SocialAccount
-		Message string
-	}{}
+	var resp Envelope[SocialAccount]
 	err = bs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // AddSocialAccount adds a new SocialAccount to the given Brewery.
 func (bs *BreweryService) AddSocialAccount(breweryID string, s *SocialAccount) error {
+	return bs.AddSocialAccountContext(context.Background(), breweryID, s)
+}
+
+// AddSocialAccountContext is like AddSocialAccount but uses the given Context for the request.
+func (bs *BreweryService) AddSocialAccountContext(ctx context.Context, breweryID string, s *SocialAccount) error {
 	// POST: /brewery/:breweryId/socialaccounts
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := bs.c.NewRequest("POST", "/brewery/"+breweryID+"/socialaccounts", s)
+	req, err := bs.c.NewRequestWithContext(ctx, "POST", "/brewery/"+breweryID+"/socialaccounts", s)
 	if err != nil {
 		return err
 	}
@@ -438,11 +520,16 @@ func (bs *BreweryService) AddSocialAccount(breweryID string, s *SocialAccount) e
 
 // UpdateSocialAccount updates a SocialAccount for the given Brewery.
 func (bs *BreweryService) UpdateSocialAccount(breweryID string, s *SocialAccount) error {
+	return bs.UpdateSocialAccountContext(context.Background(), breweryID, s)
+}
+
+// UpdateSocialAccountContext is like UpdateSocialAccount but uses the given Context for the request.
+func (bs *BreweryService) UpdateSocialAccountContext(ctx context.Context, breweryID string, s *SocialAccount) error {
 	// PUT: /brewery/:breweryId/socialaccount/:socialaccountId
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := bs.c.NewRequest("PUT", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, s.ID), s)
+	req, err := bs.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, s.ID), s)
 	if err != nil {
 		return err
 	}
@@ -452,8 +539,13 @@ func (bs *BreweryService) UpdateSocialAccount(breweryID string, s *SocialAccount
 
 // DeleteSocialAccount removes a SocialAccount from the given Brewery.
 func (bs *BreweryService) DeleteSocialAccount(breweryID string, socialAccountID int) error {
+	return bs.DeleteSocialAccountContext(context.Background(), breweryID, socialAccountID)
+}
+
+// DeleteSocialAccountContext is like DeleteSocialAccount but uses the given Context for the request.
+func (bs *BreweryService) DeleteSocialAccountContext(ctx context.Context, breweryID string, socialAccountID int) error {
 	// DELETE: /brewery/:breweryId/socialaccount/:socialaccountId
-	req, err := bs.c.NewRequest("DELETE", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, socialAccountID), nil)
+	req, err := bs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/brewery/%s/socialaccount/%d", breweryID, socialAccountID), nil)
 	if err != nil {
 		return err
 	}
@@ -59,11 +59,11 @@ func TestBreweryList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Breweries) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 breweries")
 	}
 
-	for _, b := range bl.Breweries {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Brewery ID len = %d, wanted %d", len(b.ID), l)
 		}
@@ -898,7 +898,7 @@ func TestBreweryGetRandom(t *testing.T) {
 	setup()
 	defer teardown()
 
-	data, err := os.Open("test_data/brewery.get.random.json")
+	data, err := os.Open("brewerydbtest/fixtures/brewery.get.random.json")
 	if err != nil {
 		t.Fatal("Failed to open test data file")
 	}
@@ -940,7 +940,7 @@ func ExampleBreweryService_List() {
 	if err != nil {
 		panic(err)
 	}
-	for _, b := range bl.Breweries {
+	for _, b := range bl.Data {
 		fmt.Println(b.Name, b.ID)
 	}
 }
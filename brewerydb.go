@@ -8,21 +8,26 @@ package brewerydb
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
+	"time"
 )
 
-// apiURL is not const so it can be stubbed in unit tests.
-var apiURL = "http://api.brewerydb.com/v2"
+// DefaultBaseURL is the base URL of the BreweryDB API used by a Client
+// unless overridden with WithBaseURL.
+const DefaultBaseURL = "http://api.brewerydb.com/v2"
 
-// Page is a convenience type for encoding only a page number
+// pageRequest is a convenience type for encoding only a page number
 // when paginating lists.
-type Page struct {
+type pageRequest struct {
 	P int `url:"p"`
 }
 
@@ -62,12 +67,34 @@ func (yn *YesNo) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON encodes the YesNo value as the JSON value "Y" or "N".
+func (yn YesNo) MarshalJSON() ([]byte, error) {
+	if yn {
+		return []byte(`"Y"`), nil
+	}
+	return []byte(`"N"`), nil
+}
+
 // Client serves as the interface to the BreweryDB API.
+//
+// A Client is safe for concurrent use by multiple goroutines. Its exported
+// fields should be set before the Client is first used.
 type Client struct {
-	client      http.Client
+	client      *http.Client
+	baseURL     string
+	header      http.Header
 	apiKey      string
-	NumRequests int
-	JSONWriter  io.Writer
+	JSONWriter  io.Writer     // Writes are serialized by the Client.
+	Retry       *RetryPolicy  // nil disables retries
+	Limiter     *QuotaLimiter // nil disables client-side rate limiting
+	Cache       *CachePolicy  // nil disables response caching
+	quota       quotaTracker
+	metrics     metricsRecorder
+	jsonMu      sync.Mutex
+	middleware  []Middleware
+	logger      *slog.Logger
+	tracer      Tracer
+	handler     DoFunc
 	Adjunct     *AdjunctService
 	Beer        *BeerService
 	Brewery     *BreweryService
@@ -91,10 +118,18 @@ type Client struct {
 	Yeast       *YeastService
 }
 
-// NewClient creates a new BreweryDB Client using the given API key.
-func NewClient(apiKey string) *Client {
+// NewClient creates a new BreweryDB Client using the given API key,
+// configured by the given options.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
 	c := &Client{}
 	c.apiKey = apiKey
+	c.client = &http.Client{}
+	c.baseURL = DefaultBaseURL
+	c.header = make(http.Header)
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.handler = chain(c.middleware, c.send)
 	c.Adjunct = &AdjunctService{c}
 	c.Beer = &BeerService{c}
 	c.Brewery = &BreweryService{c}
@@ -122,9 +157,16 @@ func NewClient(apiKey string) *Client {
 // NewRequest creates a new http.Request with the given method,
 // BreweryDB endpoint, and optionally a struct to be URL-encoded
 // in the request.
-func (c *Client) NewRequest(method string, endpoint string, data interface{}) (req *http.Request, err error) {
+func (c *Client) NewRequest(method string, endpoint string, data interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, endpoint, data)
+}
+
+// NewRequestWithContext is like NewRequest but associates the given
+// Context with the http.Request, so that canceling the Context or
+// exceeding its deadline aborts the request when it is passed to Do.
+func (c *Client) NewRequestWithContext(ctx context.Context, method string, endpoint string, data interface{}) (req *http.Request, err error) {
 	var u *url.URL
-	u, err = url.Parse(apiURL)
+	u, err = url.Parse(c.baseURL)
 	if err != nil {
 		return
 	}
@@ -146,7 +188,7 @@ func (c *Client) NewRequest(method string, endpoint string, data interface{}) (r
 	case "DELETE":
 		dataVals.Set("key", c.apiKey)
 		u.RawQuery = dataVals.Encode()
-		req, err = http.NewRequest(method, u.String(), nil)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), nil)
 	case "POST":
 		fallthrough
 	case "PUT":
@@ -156,7 +198,7 @@ func (c *Client) NewRequest(method string, endpoint string, data interface{}) (r
 
 		payload := dataVals.Encode()
 		body := bytes.NewBufferString(payload)
-		req, err = http.NewRequest(method, u.String(), body)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), body)
 		if err != nil {
 			return
 		}
@@ -165,37 +207,124 @@ func (c *Client) NewRequest(method string, endpoint string, data interface{}) (r
 	default:
 		err = fmt.Errorf("Unknown HTTP method: %s", method)
 	}
+	if err != nil {
+		return
+	}
+
+	for k, v := range c.header {
+		req.Header[k] = append([]string(nil), v...)
+	}
 
 	return
 }
 
 // Do performs the given http.Request and optionally
 // decodes the JSON response into the given data struct.
+// If the response has a non-2xx status, or its envelope reports a "failure"
+// status, the returned error is an *APIError. The Status and Message of a
+// successful response are recorded if the request's Context was returned
+// by WithResponseInfo.
+// The request is bound by its Context; see NewRequestWithContext.
+// Transient failures are retried according to the Client's RetryPolicy,
+// and GET responses may be served from the Client's CachePolicy.
+// The request passes through the Client's Middleware, if any, and is
+// traced by the Client's Tracer, if any.
 func (c *Client) Do(req *http.Request, data interface{}) error {
-	// TODO: [DEBUGGING] fmt.Println(req.Method, req.URL)
-	resp, err := c.client.Do(req)
+	if c.tracer != nil {
+		return c.trace(req, data)
+	}
+	return c.handler(req, data)
+}
+
+// send is the innermost DoFunc of the Client's Middleware chain.
+func (c *Client) send(req *http.Request, data interface{}) error {
+	if body, ok := c.Cache.lookup(req, c.baseURL); ok {
+		recordResponseInfo(req.Context(), body)
+		return c.decode(body, data)
+	}
+
+	resp, body, err := c.do(req)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		// TODO: return a more useful error message
-		return fmt.Errorf("HTTP Error %d", resp.StatusCode)
+		return newAPIError(resp, body, c.baseURL)
 	}
 
-	c.NumRequests++
+	c.Cache.update(req, c.baseURL, body)
+	return c.decode(body, data)
+}
 
-	if data != nil {
-		var body io.Reader
-		// if the client has a JSONWriter, also dump JSON responses
-		if c.JSONWriter != nil {
-			body = io.TeeReader(resp.Body, c.JSONWriter)
-		} else {
-			body = resp.Body
-		}
+// decode optionally decodes the given JSON response body into data.
+func (c *Client) decode(body []byte, data interface{}) error {
+	if data == nil {
+		return nil
+	}
 
-		err = json.NewDecoder(body).Decode(data)
+	// if the client has a JSONWriter, also dump JSON responses
+	if c.JSONWriter != nil {
+		c.jsonMu.Lock()
+		c.JSONWriter.Write(body)
+		c.jsonMu.Unlock()
 	}
 
-	return err
+	return json.NewDecoder(bytes.NewReader(body)).Decode(data)
+}
+
+// do sends the given http.Request, retrying it according to the Client's
+// RetryPolicy, and returns the final response along with its body. The
+// envelope of a 2xx response is decoded once, to return its "failure"
+// status as an APIError or record it in the Context's ResponseInfo.
+func (c *Client) do(req *http.Request) (*http.Response, []byte, error) {
+	for attempt := 1; ; attempt++ {
+		if err := c.quota.acquire(req.Context(), c.Limiter); err != nil {
+			return nil, nil, err
+		}
+
+		start := time.Now()
+		resp, err := c.client.Do(req)
+		err = redactError(err)
+		var body []byte
+		if err == nil {
+			c.quota.update(resp.Header, time.Now())
+			body, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		elapsed := time.Since(start)
+		endpoint := endpointPath(req.URL, c.baseURL)
+		ok := err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299
+		var env errorEnvelope
+		var failure error
+		if ok {
+			// BreweryDB may also report a failure in the envelope of a 2xx response
+			env, failure = checkFailure(resp, body, c.baseURL)
+		}
+		failed := !ok || failure != nil
+		c.metrics.record(endpointTemplate(endpoint), elapsed, len(body), failed)
+		c.logAttempt(req, endpoint, resp, err, attempt, elapsed)
+		if cs := statsFromContext(req.Context()); cs != nil {
+			cs.retries = attempt - 1
+			if resp != nil {
+				cs.status = resp.StatusCode
+			}
+		}
+
+		delay, retry := c.Retry.retryDelay(req, resp, err, attempt)
+		if !retry {
+			if failure != nil {
+				return resp, body, failure
+			}
+			if info := responseInfo(req.Context()); ok && info != nil {
+				*info = ResponseInfo{env.Status, env.Message}
+			}
+			return resp, body, err
+		}
+		c.logRetry(req, endpoint, attempt, delay)
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, nil, err
+		}
+	}
 }
@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -11,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -21,7 +24,7 @@ var (
 )
 
 func loadTestData(filename string, t *testing.T) io.ReadCloser {
-	data, err := os.Open("test_data/" + filename)
+	data, err := os.Open("brewerydbtest/fixtures/" + filename)
 	if err != nil {
 		t.Fatal("Failed to open test data file")
 	}
@@ -32,8 +35,7 @@ func setup() {
 	mux = http.NewServeMux()
 	server = httptest.NewServer(mux)
 
-	apiURL = server.URL
-	client = NewClient(fakeKey)
+	client = NewClient(fakeKey, WithBaseURL(server.URL))
 }
 
 func teardown() {
@@ -90,12 +92,12 @@ func checkPostFormDNE(t *testing.T, r *http.Request, keys ...string) {
 
 // Executes fn, expecting it to return an error
 func testBadURL(t *testing.T, fn func() error) {
-	origURL := apiURL
-	apiURL = "http://%api.brewerydb.com/v2"
+	origURL := client.baseURL
+	client.baseURL = "http://%api.brewerydb.com/v2"
 	if err := fn(); err == nil {
 		t.Fatal("expected HTTP Request URL error")
 	}
-	apiURL = origURL
+	client.baseURL = origURL
 }
 
 func TestNewRequest(t *testing.T) {
@@ -137,13 +139,99 @@ func TestDo(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	client.client.Transport = testTransport{}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithTransport(testTransport{}))
 	_, err = client.Beer.Get(beerID)
 	if err == nil {
 		t.Fatal("Expected net/http Do error")
 	}
 }
 
+func TestDoContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	started := make(chan struct{})
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/heartbeat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(req, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Do error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDoContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Beer.GetContext(ctx, "o9TSOv"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Beer.GetContext error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestServiceContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s with canceled Context", r.Method, r.URL.Path)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := map[string]func() error{
+		"Adjunct":     func() error { _, err := client.Adjunct.GetContext(ctx, 1); return err },
+		"Beer":        func() error { _, err := client.Beer.ListContext(ctx, &BeerListRequest{}); return err },
+		"BeerRandom":  func() error { _, err := client.Beer.GetRandomContext(ctx, &RandomBeerRequest{}); return err },
+		"Brewery":     func() error { return client.Brewery.DeleteContext(ctx, "jmGoBA") },
+		"Category":    func() error { _, err := client.Category.ListContext(ctx); return err },
+		"Change":      func() error { _, err := client.Change.ListContext(ctx, nil); return err },
+		"ConvertID":   func() error { _, err := client.ConvertID.ConvertIDsContext(ctx, ConvertBeer, 1); return err },
+		"Event":       func() error { return client.Event.UpdateContext(ctx, "cJio9R", &Event{}) },
+		"AwardCat":    func() error { _, err := client.Event.ListAwardCategoriesContext(ctx, "cJio9R"); return err },
+		"AwardPlace":  func() error { _, err := client.Event.ListAwardPlacesContext(ctx, "cJio9R"); return err },
+		"Feature":     func() error { _, err := client.Feature.GetContext(ctx); return err },
+		"Fermentable": func() error { _, err := client.Fermentable.ListContext(ctx, 1); return err },
+		"Fluidsize":   func() error { _, err := client.Fluidsize.ListContext(ctx); return err },
+		"Glass":       func() error { _, err := client.Glass.GetContext(ctx, 1); return err },
+		"Guild":       func() error { _, err := client.Guild.AddContext(ctx, &Guild{Name: "guild"}); return err },
+		"Heartbeat":   func() error { return client.Heartbeat.HeartbeatContext(ctx) },
+		"Hop":         func() error { _, err := client.Hop.ListContext(ctx, 1); return err },
+		"Ingredient":  func() error { _, err := client.Ingredient.GetContext(ctx, 1); return err },
+		"Location":    func() error { _, err := client.Location.GetContext(ctx, "z9H9uv"); return err },
+		"Menu":        func() error { _, err := client.Menu.StylesContext(ctx); return err },
+		"Search":      func() error { _, err := client.Search.GeoPointContext(ctx, &GeoPointRequest{}); return err },
+		"SearchUPC":   func() error { _, err := client.Search.UPCContext(ctx, 606905008303); return err },
+		"SocialSite":  func() error { _, err := client.SocialSite.ListContext(ctx); return err },
+		"Style":       func() error { _, err := client.Style.GetContext(ctx, 1); return err },
+		"Yeast":       func() error { _, err := client.Yeast.ListContext(ctx, 1); return err },
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, context.Canceled) {
+			t.Errorf("%s error = %v, want %v", name, err, context.Canceled)
+		}
+	}
+}
+
 func TestYesNoUnmarshalJSON(t *testing.T) {
 	q := struct {
 		IsPrimary YesNo `url:"isPrimary"`
@@ -204,7 +292,7 @@ func Example_dragonsmilk() {
 	}
 
 	var beerID string
-	for _, beer := range bl.Beers {
+	for _, beer := range bl.Data {
 		if beer.Name == "Dragon's Milk" {
 			beerID = beer.ID
 		}
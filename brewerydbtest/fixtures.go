@@ -0,0 +1,66 @@
+package brewerydbtest
+
+import (
+	"embed"
+	"encoding/json"
+	"strings"
+
+	"github.com/naegelejd/brewerydb/internal/fakeapi"
+)
+
+// fixtures holds the API responses recorded by test_data/get_test_data.go,
+// which are also used by the brewerydb tests.
+//
+//go:embed fixtures
+var fixtures embed.FS
+
+// fixtureTypes are the entity types seeded from the fixtures.
+var fixtureTypes = []string{"beer", "brewery", "location", "event", "guild"}
+
+// seed serves the menus in the fixtures from h and, unless menusOnly is
+// set, adds the Beers, Breweries, Locations, Events and Guilds in them.
+func seed(h *fakeapi.Handler, menusOnly bool) error {
+	paths, err := fixtures.ReadDir("fixtures")
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		name, ok := strings.CutPrefix(p.Name(), "menu.")
+		if !ok {
+			continue
+		}
+		data, err := fixtures.ReadFile("fixtures/" + p.Name())
+		if err != nil {
+			return err
+		}
+		h.SetMenuResponse(strings.TrimSuffix(name, ".json"), data)
+	}
+	if menusOnly {
+		return nil
+	}
+
+	for _, typ := range fixtureTypes {
+		var list struct{ Data []map[string]interface{} }
+		var get struct{ Data map[string]interface{} }
+		if err := decode("fixtures/"+typ+".list.json", &list); err != nil {
+			return err
+		}
+		if err := decode("fixtures/"+typ+".get.json", &get); err != nil {
+			return err
+		}
+		for _, e := range append(list.Data, get.Data) {
+			if err := h.Insert(typ, e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func decode(name string, v interface{}) error {
+	data, err := fixtures.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,195 @@
+// Copyright 2015 Joseph Naegele. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package brewerydbtest provides an in-memory fake BreweryDB server for
+// testing code that uses package brewerydb.
+//
+// A Server is seeded with the recorded API responses in its fixtures
+// directory and supports listing, getting, adding, updating and deleting
+// Beers, Breweries, Locations, Events and Guilds, as well as search and the
+// menu endpoints:
+//
+//	srv := brewerydbtest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//	beer, err := client.Beer.Get("o9TSOv")
+package brewerydbtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+
+	"github.com/naegelejd/brewerydb"
+	"github.com/naegelejd/brewerydb/internal/fakeapi"
+)
+
+// DefaultPageSize is the number of results per page of a list or search,
+// as used by BreweryDB.
+const DefaultPageSize = fakeapi.DefaultPageSize
+
+// Server is a fake BreweryDB API server. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for use with brewerydb.WithBaseURL.
+	URL string
+
+	srv      *httptest.Server
+	apiKey   string
+	premium  bool
+	pageSize int
+	empty    bool
+	handler  *fakeapi.Handler
+
+	mu     sync.Mutex
+	faults []*Fault
+}
+
+// Option configures a Server. Pass Options to NewServer.
+type Option func(*Server)
+
+// WithAPIKey causes the Server to reject requests not using the given API key.
+func WithAPIKey(key string) Option {
+	return func(s *Server) {
+		s.apiKey = key
+	}
+}
+
+// WithPremium makes the Server treat the API key as a premium membership,
+// allowing unfiltered lists and premium-only parameters such as
+// withSocialAccounts and withIngredients.
+func WithPremium() Option {
+	return func(s *Server) {
+		s.premium = true
+	}
+}
+
+// WithPageSize sets the number of results per page of a list or search.
+// A size less than 1 is ignored.
+func WithPageSize(n int) Option {
+	return func(s *Server) {
+		if n > 0 {
+			s.pageSize = n
+		}
+	}
+}
+
+// WithoutFixtures starts the Server with no Beers, Breweries, Locations,
+// Events or Guilds. The menu endpoints are still served.
+func WithoutFixtures() Option {
+	return func(s *Server) {
+		s.empty = true
+	}
+}
+
+// NewServer starts and returns a new Server, seeded with the fixtures.
+// The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{pageSize: DefaultPageSize}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.handler = fakeapi.New()
+	if err := seed(s.handler, s.empty); err != nil {
+		panic("brewerydbtest: " + err.Error())
+	}
+	s.handler.Premium = s.premium
+	s.handler.PageSize = s.pageSize
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the Server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a brewerydb.Client that sends requests to the Server
+// using its API key. Additional options are applied after the base URL.
+func (s *Server) Client(opts ...brewerydb.ClientOption) *brewerydb.Client {
+	opts = append([]brewerydb.ClientOption{brewerydb.WithBaseURL(s.URL)}, opts...)
+	return brewerydb.NewClient(s.apiKey, opts...)
+}
+
+// Fault describes an error returned by the Server instead of handling
+// matching requests.
+type Fault struct {
+	Method  string      // HTTP method to match, or "" to match any
+	Path    string      // path.Match pattern, e.g. "/beer/*", or "" to match any
+	Status  int         // HTTP status of the error response (default: 500)
+	Message string      // errorMessage of the error response (default: the status text)
+	Header  http.Header // Additional response headers, e.g. Retry-After
+	Times   int         // Number of requests to fail, or 0 to fail all of them
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if f.Path == "" {
+		return true
+	}
+	ok, _ := path.Match(f.Path, r.URL.Path)
+	return ok
+}
+
+// Inject causes the Server to fail requests matching f. Faults are
+// matched in the order they were injected.
+func (s *Server) Inject(f Fault) {
+	if f.Status == 0 {
+		f.Status = http.StatusInternalServerError
+	}
+	if f.Message == "" {
+		f.Message = http.StatusText(f.Status)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected Faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// fault returns the first injected Fault matching r, if any, and counts
+// the request against it.
+func (s *Server) fault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	f := s.fault(r)
+	s.mu.Unlock()
+	if f != nil {
+		for k, v := range f.Header {
+			w.Header()[k] = v
+		}
+		fakeapi.WriteError(w, f.Status, f.Message)
+		return
+	}
+
+	if s.apiKey != "" && r.URL.Query().Get("key") != s.apiKey {
+		fakeapi.WriteError(w, http.StatusUnauthorized, "API key could not be found")
+		return
+	}
+	s.handler.ServeHTTP(w, r)
+}
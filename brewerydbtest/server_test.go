@@ -0,0 +1,284 @@
+package brewerydbtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naegelejd/brewerydb"
+)
+
+func TestGet(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	beer, err := client.Beer.Get("o9TSOv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beer.ID != "o9TSOv" || beer.Name == "" {
+		t.Fatalf("Beer = %+v", beer)
+	}
+
+	_, err = client.Brewery.Get("nope")
+	if !brewerydb.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	srv := NewServer(WithPageSize(10))
+	defer srv.Close()
+	client := srv.Client()
+
+	first, err := client.Location.List(&brewerydb.LocationListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.CurrentPage != 1 || len(first.Data) != 10 {
+		t.Fatalf("page 1 = %d with %d Locations", first.CurrentPage, len(first.Data))
+	}
+	if want := (first.TotalResults + 9) / 10; first.NumberOfPages != want {
+		t.Fatalf("NumberOfPages = %d, want %d", first.NumberOfPages, want)
+	}
+
+	seen := map[string]bool{}
+	for p := 1; p <= first.NumberOfPages; p++ {
+		ll, err := client.Location.List(&brewerydb.LocationListRequest{Page: p})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range ll.Data {
+			seen[l.ID] = true
+		}
+	}
+	if len(seen) != first.TotalResults {
+		t.Fatalf("saw %d Locations, want %d", len(seen), first.TotalResults)
+	}
+}
+
+func TestInvalidPageSize(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		srv := NewServer(WithPageSize(n))
+		ll, err := srv.Client().Location.List(&brewerydb.LocationListRequest{})
+		srv.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := min(ll.TotalResults, DefaultPageSize); len(ll.Data) != want {
+			t.Errorf("WithPageSize(%d) listed %d Locations, want %d", n, len(ll.Data), want)
+		}
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	bl, err := client.Beer.List(&brewerydb.BeerListRequest{StyleID: 15})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bl.TotalResults == 0 {
+		t.Fatal("no Beers with style 15")
+	}
+	for _, b := range bl.Data {
+		if b.StyleID != 15 {
+			t.Errorf("Beer %s has style %d, want 15", b.ID, b.StyleID)
+		}
+	}
+
+	bl, err = client.Beer.List(&brewerydb.BeerListRequest{ABV: brewerydb.Between(8, 9)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bl.TotalResults == 0 {
+		t.Fatal("no Beers with ABV between 8 and 9")
+	}
+	for _, b := range bl.Data {
+		if !b.ABV.Within(brewerydb.NewNumber(8), brewerydb.NewNumber(9)) {
+			t.Errorf("Beer %s has ABV %v", b.ID, b.ABV)
+		}
+	}
+
+	bl, err = client.Beer.List(&brewerydb.BeerListRequest{IDs: "o9TSOv,9O3QPg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bl.TotalResults != 2 {
+		t.Fatalf("got %d Beers, want 2", bl.TotalResults)
+	}
+}
+
+func TestPremium(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	if _, err := client.Beer.List(&brewerydb.BeerListRequest{}); !brewerydb.IsPremiumRequired(err) {
+		t.Errorf("expected premium error for unfiltered list, got %v", err)
+	}
+	q := &brewerydb.BeerListRequest{Name: "(512) One", WithSocialAccounts: true}
+	if _, err := client.Beer.List(q); !brewerydb.IsPremiumRequired(err) {
+		t.Errorf("expected premium error for withSocialAccounts, got %v", err)
+	}
+
+	premium := NewServer(WithPremium())
+	defer premium.Close()
+	bl, err := premium.Client().Beer.List(&brewerydb.BeerListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bl.TotalResults == 0 {
+		t.Fatal("no Beers listed")
+	}
+}
+
+func TestAddUpdateDelete(t *testing.T) {
+	srv := NewServer(WithoutFixtures())
+	defer srv.Close()
+	client := srv.Client()
+
+	id, err := client.Beer.Add(&brewerydb.Beer{Name: "Test Ale", StyleID: 15, ABV: brewerydb.NewNumber(5.5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beer, err := client.Beer.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beer.Name != "Test Ale" || beer.StyleID != 15 || beer.ABV != brewerydb.NewNumber(5.5) {
+		t.Fatalf("added Beer = %+v", beer)
+	}
+
+	beer.Name = "Test Lager"
+	if err := client.Beer.Update(id, &beer); err != nil {
+		t.Fatal(err)
+	}
+	if beer, err = client.Beer.Get(id); err != nil || beer.Name != "Test Lager" {
+		t.Fatalf("updated Beer = %+v, %v", beer, err)
+	}
+
+	if err := client.Beer.Delete(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Beer.Get(id); !brewerydb.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if _, err := client.Guild.Add(&brewerydb.Guild{}); err == nil {
+		t.Fatal("expected error adding Guild without a name")
+	}
+}
+
+func TestBreweryLocations(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	id, err := client.Brewery.AddLocation("jmGoBA", &brewerydb.Location{Locality: "Austin", CountryISOCode: "US"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ll, err := client.Brewery.ListLocations("jmGoBA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range ll {
+		if l.ID == id {
+			if l.Locality != "Austin" || l.BreweryID != "jmGoBA" {
+				t.Fatalf("added Location = %+v", l)
+			}
+			return
+		}
+	}
+	t.Fatalf("Location %s not listed", id)
+}
+
+func TestSearch(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	bl, err := client.Search.Beer("ale", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bl.TotalResults == 0 {
+		t.Fatal("no Beers found")
+	}
+	for _, b := range bl.Data {
+		if !strings.Contains(strings.ToLower(b.Name), "ale") {
+			t.Errorf("Beer %q does not match", b.Name)
+		}
+	}
+
+	for r, want := range map[brewerydb.Range]int{brewerydb.Exactly(8): bl.TotalResults, brewerydb.AtLeast(9): 0} {
+		sl, err := client.Search.Beer("ale", &brewerydb.SearchRequest{ABV: r})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sl.TotalResults != want {
+			t.Errorf("found %d Beers with ABV %v, want %d", sl.TotalResults, r, want)
+		}
+	}
+}
+
+func TestMenu(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srm, err := srv.Client().Menu.SRM()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srm) == 0 || srm[0].Hex == "" {
+		t.Fatalf("SRM = %+v", srm)
+	}
+}
+
+func TestInject(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	srv.Inject(Fault{Method: "GET", Path: "/beer/*", Status: http.StatusServiceUnavailable, Times: 1})
+	if _, err := client.Beer.Get("o9TSOv"); err == nil {
+		t.Fatal("expected injected error")
+	}
+	if _, err := client.Beer.Get("o9TSOv"); err != nil {
+		t.Fatalf("fault not cleared after Times requests: %v", err)
+	}
+
+	srv.Inject(Fault{Status: http.StatusServiceUnavailable, Times: 2})
+	client.Retry = &brewerydb.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	if _, err := client.Menu.SRM(); err != nil {
+		t.Fatalf("expected retries to succeed, got %v", err)
+	}
+
+	srv.Inject(Fault{Status: http.StatusTooManyRequests})
+	client.Retry = nil
+	if _, err := client.Hop.Get(1); !brewerydb.IsRateLimited(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+	srv.ClearFaults()
+	if _, err := client.Menu.SRM(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	srv := NewServer(WithAPIKey("secret"))
+	defer srv.Close()
+
+	if _, err := srv.Client().Menu.SRM(); err != nil {
+		t.Fatal(err)
+	}
+	c := brewerydb.NewClient("wrong", brewerydb.WithBaseURL(srv.URL))
+	if _, err := c.Menu.SRM(); !brewerydb.IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package brewerydbtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naegelejd/brewerydb"
+)
+
+// webhookAction returns the action sent in a webhook for a ChangeAction.
+func webhookAction(a brewerydb.ChangeAction) string {
+	if a == brewerydb.ChangeAdd {
+		return "insert"
+	}
+	return string(a)
+}
+
+// NewWebhookRequest returns a request delivering ev to the given URL as
+// BreweryDB would, signed with the given API key. A random nonce and the
+// current time are used if ev has no Nonce or Timestamp.
+func NewWebhookRequest(target, apiKey string, ev brewerydb.WebhookEvent) (*http.Request, error) {
+	if ev.Nonce == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		ev.Nonce = hex.EncodeToString(b)
+	}
+	if ev.Timestamp.IsZero() {
+		ev.Timestamp = time.Now()
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("nonce", ev.Nonce)
+	q.Set("key", brewerydb.SignWebhook(apiKey, ev.Nonce))
+	u.RawQuery = q.Encode()
+
+	sub := "none"
+	if ev.SubAttributeName != "" {
+		sub = string(ev.SubAttributeName) + "-" + webhookAction(ev.SubAction)
+	}
+	form := url.Values{
+		"attribute":   {string(ev.AttributeName)},
+		"attributeId": {ev.AttributeID},
+		"action":      {webhookAction(ev.Action)},
+		"subAction":   {sub},
+		"timestamp":   {strconv.FormatInt(ev.Timestamp.Unix(), 10)},
+	}
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// PostWebhook delivers ev to the given URL as BreweryDB would, signed with
+// the given API key. See NewWebhookRequest.
+func PostWebhook(target, apiKey string, ev brewerydb.WebhookEvent) (*http.Response, error) {
+	req, err := NewWebhookRequest(target, apiKey, ev)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
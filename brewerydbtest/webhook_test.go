@@ -0,0 +1,53 @@
+package brewerydbtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/naegelejd/brewerydb"
+)
+
+func TestPostWebhook(t *testing.T) {
+	const key = "abcdefghijklmnopqrstuvwxyz"
+	events := make(chan brewerydb.WebhookEvent, 1)
+	h := brewerydb.NewWebhookHandler(key)
+	h.Handle(brewerydb.ChangeLocation, brewerydb.ChangeAdd, func(_ context.Context, ev brewerydb.WebhookEvent) error {
+		events <- ev
+		return nil
+	})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	ev := brewerydb.WebhookEvent{
+		AttributeName:    brewerydb.ChangeLocation,
+		AttributeID:      "qxAQSJ",
+		Action:           brewerydb.ChangeAdd,
+		SubAttributeName: brewerydb.ChangeBeer,
+		SubAction:        brewerydb.ChangeEdit,
+	}
+	resp, err := PostWebhook(srv.URL+"/webhook", key, ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	got := <-events
+	if got.AttributeID != "qxAQSJ" || got.SubAttributeName != brewerydb.ChangeBeer ||
+		got.SubAction != brewerydb.ChangeEdit || got.Nonce == "" || time.Since(got.Timestamp) > time.Minute {
+		t.Errorf("event = %+v", got)
+	}
+
+	resp, err = PostWebhook(srv.URL+"/webhook", "wrong key", ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,285 @@
+package brewerydb
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores BreweryDB response bodies by key. Keys are an endpoint
+// path followed by "?" and the encoded query, e.g. "/hop/1?", and never
+// contain the API key. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the unexpired value stored for key, if any.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key until ttl has elapsed.
+	Set(key string, value []byte, ttl time.Duration)
+	// Invalidate removes all values whose key begins with prefix.
+	Invalidate(prefix string)
+}
+
+// CachePolicy configures how a Client caches responses to GET requests.
+// Set Client.Cache to enable caching.
+//
+// Successful PUT, POST and DELETE requests invalidate the cached responses
+// of the entity they touch, e.g. updating Beer "o9TSOv" invalidates
+// "/beer/o9TSOv", "/beer/o9TSOv/hops", etc. and all pages of "/beers".
+//
+// Random results, e.g. from "/beer/random" or lists with order=random, are
+// never cached.
+type CachePolicy struct {
+	Cache Cache
+	// TTL maps endpoint templates, e.g. "/menu/styles" or "/hop/:hopId",
+	// to how long their responses are cached.
+	TTL map[string]time.Duration
+	// DefaultTTL applies to endpoints not found in TTL.
+	// Zero disables caching of those endpoints.
+	DefaultTTL time.Duration
+	// OnInvalidate, if set, is called with the endpoint of every entity
+	// (e.g. "/beer/o9TSOv") whose cached responses are invalidated.
+	OnInvalidate func(entity string)
+}
+
+// entityCollections maps entities that can be added, updated and deleted
+// to the endpoint that lists them.
+var entityCollections = map[string]string{
+	"beer":     "/beers",
+	"brewery":  "/breweries",
+	"event":    "/events",
+	"guild":    "/guilds",
+	"location": "/locations",
+}
+
+// cacheKey returns the Cache key for the given request URL.
+func cacheKey(u *url.URL, baseURL string) string {
+	q := u.Query()
+	q.Del("key")
+	return strings.TrimSuffix(endpointPath(u, baseURL), "/") + "?" + q.Encode()
+}
+
+// isRandom reports whether the given request asks for random results.
+func isRandom(u *url.URL) bool {
+	return strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), "/random") || u.Query().Get("order") == "random"
+}
+
+// lookup returns the cached response body for the given request.
+func (p *CachePolicy) lookup(req *http.Request, baseURL string) ([]byte, bool) {
+	if p == nil || p.Cache == nil || req.Method != "GET" || isRandom(req.URL) {
+		return nil, false
+	}
+	return p.Cache.Get(cacheKey(req.URL, baseURL))
+}
+
+// update stores the response body of a GET request, or invalidates the
+// cached responses of the entity touched by any other request.
+func (p *CachePolicy) update(req *http.Request, baseURL string, body []byte) {
+	if p == nil || p.Cache == nil {
+		return
+	}
+
+	endpoint := endpointPath(req.URL, baseURL)
+	if req.Method == "GET" {
+		if isRandom(req.URL) {
+			return
+		}
+		ttl, ok := p.TTL[endpointTemplate(endpoint)]
+		if !ok {
+			ttl = p.DefaultTTL
+		}
+		if ttl > 0 {
+			p.Cache.Set(cacheKey(req.URL, baseURL), body, ttl)
+		}
+		return
+	}
+
+	segs := strings.Split(strings.Trim(endpoint, "/"), "/")
+	entity := "/" + segs[0]
+	if len(segs) > 1 && idResources[segs[0]] {
+		entity += "/" + segs[1]
+	}
+	p.Cache.Invalidate(entity + "?")
+	p.Cache.Invalidate(entity + "/")
+	if coll, ok := entityCollections[segs[0]]; ok {
+		p.Cache.Invalidate(coll + "?")
+	}
+	if p.OnInvalidate != nil {
+		p.OnInvalidate(entity)
+	}
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entries once it holds its maximum number of entries.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List // of *memoryEntry, most recently used first
+	entries    map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding at most maxEntries
+// responses, or an unlimited number if maxEntries <= 0.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache.
+func (mc *MemoryCache) Get(key string) ([]byte, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	el, ok := mc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*memoryEntry)
+	if time.Now().After(e.expires) {
+		mc.remove(el)
+		return nil, false
+	}
+	mc.ll.MoveToFront(el)
+	return e.value, true
+}
+
+// Set implements Cache.
+func (mc *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	e := &memoryEntry{key, value, time.Now().Add(ttl)}
+	if el, ok := mc.entries[key]; ok {
+		el.Value = e
+		mc.ll.MoveToFront(el)
+		return
+	}
+	mc.entries[key] = mc.ll.PushFront(e)
+	if mc.maxEntries > 0 && mc.ll.Len() > mc.maxEntries {
+		mc.remove(mc.ll.Back())
+	}
+}
+
+// Invalidate implements Cache.
+func (mc *MemoryCache) Invalidate(prefix string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	for key, el := range mc.entries {
+		if strings.HasPrefix(key, prefix) {
+			mc.remove(el)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache, including expired
+// entries that have not yet been removed.
+func (mc *MemoryCache) Len() int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.ll.Len()
+}
+
+func (mc *MemoryCache) remove(el *list.Element) {
+	mc.ll.Remove(el)
+	delete(mc.entries, el.Value.(*memoryEntry).key)
+}
+
+// DiskCache is a Cache that stores each response in a file in a directory.
+// Errors reading or writing files are treated as cache misses.
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+type diskEntry struct {
+	Key     string
+	Value   []byte
+	Expires time.Time
+}
+
+// NewDiskCache creates a DiskCache storing responses in the given
+// directory, creating it if necessary.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (dc *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dc.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (dc *DiskCache) read(path string) (diskEntry, bool) {
+	var e diskEntry
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return e, false
+	}
+	return e, json.Unmarshal(data, &e) == nil
+}
+
+// Get implements Cache.
+func (dc *DiskCache) Get(key string) ([]byte, bool) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	p := dc.path(key)
+	e, ok := dc.read(p)
+	if !ok || e.Key != key {
+		return nil, false
+	}
+	if time.Now().After(e.Expires) {
+		os.Remove(p)
+		return nil, false
+	}
+	return e.Value, true
+}
+
+// Set implements Cache.
+func (dc *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	data, err := json.Marshal(diskEntry{key, value, time.Now().Add(ttl)})
+	if err != nil {
+		return
+	}
+	// write to a temporary file first so readers never see partial entries
+	p := dc.path(key)
+	tmp := p + ".tmp"
+	if os.WriteFile(tmp, data, 0644) != nil {
+		return
+	}
+	os.Rename(tmp, p)
+}
+
+// Invalidate implements Cache.
+func (dc *DiskCache) Invalidate(prefix string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	paths, _ := filepath.Glob(filepath.Join(dc.dir, "*.json"))
+	for _, p := range paths {
+		if e, ok := dc.read(p); !ok || strings.HasPrefix(e.Key, prefix) {
+			os.Remove(p)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package brewerydb
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheGET(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mc := NewMemoryCache(0)
+	client.Cache = &CachePolicy{
+		Cache: mc,
+		TTL:   map[string]time.Duration{"/menu/styles": time.Hour},
+	}
+
+	styleRequests := 0
+	mux.HandleFunc("/menu/styles", func(w http.ResponseWriter, r *http.Request) {
+		styleRequests++
+		data := loadTestData("menu.styles.json", t)
+		defer data.Close()
+		io.Copy(w, data)
+	})
+	hopRequests := 0
+	mux.HandleFunc("/hop/", func(w http.ResponseWriter, r *http.Request) {
+		hopRequests++
+		fmt.Fprint(w, `{"status":"success","data":{"id":1}}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		styles, err := client.Menu.Styles()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(styles) <= 0 {
+			t.Fatal("Expected >0 styles")
+		}
+		if _, err := client.Hop.Get(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if styleRequests != 1 {
+		t.Fatalf("/menu/styles requests = %d, want 1", styleRequests)
+	}
+	if hopRequests != 3 {
+		t.Fatalf("/hop/1 requests = %d, want 3", hopRequests)
+	}
+
+	if _, ok := mc.Get("/menu/styles?"); !ok {
+		t.Fatal("expected /menu/styles to be cached without API key")
+	}
+}
+
+func TestCacheRandom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Cache = &CachePolicy{Cache: NewMemoryCache(0), DefaultTTL: time.Hour}
+
+	requests := map[string]int{}
+	mux.HandleFunc("/beer/random", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		fmt.Fprint(w, `{"status":"success","data":{"id":"o9TSOv"}}`)
+	})
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path+" "+r.FormValue("order")]++
+		fmt.Fprint(w, `{"status":"success","currentPage":1,"numberOfPages":1,"data":[]}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Beer.GetRandom(&RandomBeerRequest{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Beer.List(&BeerListRequest{Order: BeerOrderRandom}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Beer.List(&BeerListRequest{Order: BeerOrderName}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := map[string]int{"/beer/random": 3, "/beers random": 3, "/beers name": 1}
+	if fmt.Sprint(requests) != fmt.Sprint(want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var invalidated []string
+	client.Cache = &CachePolicy{
+		Cache:        NewMemoryCache(0),
+		DefaultTTL:   time.Hour,
+		OnInvalidate: func(entity string) { invalidated = append(invalidated, entity) },
+	}
+
+	const id = "o9TSOv"
+	requests := 0
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			requests++
+		}
+		if strings.HasSuffix(r.URL.Path, "/hops") {
+			fmt.Fprint(w, `{"status":"success","data":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"id":"%s"}}`, id)
+	})
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"currentPage":1,"numberOfPages":1,"data":[]}`)
+	})
+
+	get := func() {
+		if _, err := client.Beer.Get(id); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Beer.ListHops(id); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Beer.List(&BeerListRequest{Name: "Truth"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	get()
+	get()
+	if requests != 3 {
+		t.Fatalf("requests = %d, want 3", requests)
+	}
+
+	if err := client.Beer.Update(id, makeTestBeer()); err != nil {
+		t.Fatal(err)
+	}
+	if len(invalidated) != 1 || invalidated[0] != "/beer/"+id {
+		t.Fatalf("invalidated = %v, want [/beer/%s]", invalidated, id)
+	}
+
+	get()
+	if requests != 6 {
+		t.Fatalf("requests = %d, want 6", requests)
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	mc := NewMemoryCache(2)
+	mc.Set("/hop/1?", []byte("1"), time.Hour)
+	mc.Set("/hop/2?", []byte("2"), time.Hour)
+	mc.Get("/hop/1?")
+	mc.Set("/hop/3?", []byte("3"), time.Hour)
+
+	// /hop/2 was least recently used
+	if _, ok := mc.Get("/hop/2?"); ok {
+		t.Fatal("expected /hop/2? to be evicted")
+	}
+	if v, ok := mc.Get("/hop/1?"); !ok || string(v) != "1" {
+		t.Fatalf("Get(/hop/1?) = %q, %v, want 1, true", v, ok)
+	}
+	if mc.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", mc.Len())
+	}
+
+	mc.Set("/hop/1?", []byte("1"), -time.Second)
+	if _, ok := mc.Get("/hop/1?"); ok {
+		t.Fatal("expected /hop/1? to be expired")
+	}
+
+	mc.Invalidate("/hop/")
+	if mc.Len() != 0 {
+		t.Fatalf("Len = %d after Invalidate, want 0", mc.Len())
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dc.Set("/beer/o9TSOv?", []byte(`{"id":"o9TSOv"}`), time.Hour)
+	dc.Set("/beer/o9TSOv/hops?", []byte(`[]`), time.Hour)
+	dc.Set("/hop/1?", []byte(`{"id":1}`), time.Hour)
+	dc.Set("/hop/2?", []byte(`{"id":2}`), -time.Second)
+
+	// a new DiskCache in the same directory sees the same entries
+	dc, err = NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := dc.Get("/beer/o9TSOv?"); !ok || !strings.Contains(string(v), "o9TSOv") {
+		t.Fatalf("Get(/beer/o9TSOv?) = %q, %v", v, ok)
+	}
+	if _, ok := dc.Get("/hop/2?"); ok {
+		t.Fatal("expected /hop/2? to be expired")
+	}
+
+	dc.Invalidate("/beer/o9TSOv")
+	if _, ok := dc.Get("/beer/o9TSOv/hops?"); ok {
+		t.Fatal("expected /beer/o9TSOv/hops? to be invalidated")
+	}
+	if _, ok := dc.Get("/hop/1?"); !ok {
+		t.Fatal("expected /hop/1? to remain cached")
+	}
+
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Fatalf("cache directory contains %d files, want 1", len(files))
+	}
+}
@@ -1,6 +1,7 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -18,25 +19,26 @@ type Category struct {
 	ID          int
 	Name        string
 	Description string
-	CreateDate  string
-	UpdateDate  string
+	CreateDate  Timestamp
+	UpdateDate  Timestamp
 }
 
 // List returns all possible Beer Categories.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/category_index#1
 func (cs *CategoryService) List() ([]Category, error) {
+	return cs.ListContext(context.Background())
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (cs *CategoryService) ListContext(ctx context.Context) ([]Category, error) {
 	// GET: /categories
-	req, err := cs.c.NewRequest("GET", "/categories", nil)
+	req, err := cs.c.NewRequestWithContext(ctx, "GET", "/categories", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Category
-		Message string
-	}{}
+	var resp Envelope[[]Category]
 	err = cs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -45,18 +47,19 @@ func (cs *CategoryService) List() ([]Category, error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/category_index#2
 func (cs *CategoryService) Get(id int) (cat Category, err error) {
+	return cs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (cs *CategoryService) GetContext(ctx context.Context, id int) (cat Category, err error) {
 	// GET: /category/:categoryId
 	var req *http.Request
-	req, err = cs.c.NewRequest("GET", fmt.Sprintf("/category/%d", id), nil)
+	req, err = cs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/category/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Category
-		Message string
-	}{}
+	var resp Envelope[Category]
 	err = cs.c.Do(req, &resp)
 	return resp.Data, err
 }
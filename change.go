@@ -1,6 +1,9 @@
 package brewerydb
 
+import "context"
+import "iter"
 import "net/http"
+import "time"
 
 // ChangeService provides access to the BreweryDB Change API.
 // Use Client.Change.
@@ -37,7 +40,7 @@ type ChangeListRequest struct {
 	Page          int        `url:"p,omitempty"`
 	AttributeName ChangeType `url:"attributeName,omitempty"`
 	AttributeID   string     `url:"attributeId,omitempty"`
-	Since         string     `url:"since,omitempty"`
+	Since         time.Time  `url:"since,omitempty,unix"` // Max 30 days ago
 }
 
 // Attribute is a generic object that contains the ID and Name of either a
@@ -55,24 +58,25 @@ type Change struct {
 	SubAttributeName ChangeType
 	SubAction        ChangeAction
 	SubAttribute     Attribute
+	ChangeDate       Timestamp
 }
 
 // ChangeList represents one "page" containing a slice of Changes.
-type ChangeList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Changes       []Change `json:"data"`
-}
+type ChangeList = Page[Change]
 
 // List retrieves (by default) a paginated list of all Changes to BreweryDB
 // in the last 30 days.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/change_index#1
 func (cs *ChangeService) List(q *ChangeListRequest) (cl ChangeList, err error) {
+	return cs.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (cs *ChangeService) ListContext(ctx context.Context, q *ChangeListRequest) (cl ChangeList, err error) {
 	// GET: /changes
 	var req *http.Request
-	req, err = cs.c.NewRequest("GET", "/changes", q)
+	req, err = cs.c.NewRequestWithContext(ctx, "GET", "/changes", q)
 	if err != nil {
 		return
 	}
@@ -80,3 +84,46 @@ func (cs *ChangeService) List(q *ChangeListRequest) (cl ChangeList, err error) {
 	err = cs.c.Do(req, &cl)
 	return
 }
+
+// All returns an iterator over the Changes on every page matching the
+// given ChangeListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (cs *ChangeService) All(q *ChangeListRequest) iter.Seq2[Change, error] {
+	return cs.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (cs *ChangeService) AllContext(ctx context.Context, q *ChangeListRequest) iter.Seq2[Change, error] {
+	var req ChangeListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Change, int, error) {
+		r := req
+		r.Page = page
+		l, err := cs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Changes on every page matching the given
+// ChangeListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (cs *ChangeService) FetchAll(q *ChangeListRequest, workers int) ([]Change, error) {
+	return cs.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (cs *ChangeService) FetchAllContext(ctx context.Context, q *ChangeListRequest, workers int) ([]Change, error) {
+	var req ChangeListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Change, int, error) {
+		r := req
+		r.Page = page
+		l, err := cs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
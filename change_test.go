@@ -126,11 +126,11 @@ func TestChangeList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(cl.Changes) <= 0 {
+	if len(cl.Data) <= 0 {
 		t.Fatal("Expected >0 Changes")
 	}
 
-	for _, c := range cl.Changes {
+	for _, c := range cl.Data {
 		if c.AttributeName != attrName {
 			t.Fatalf("AttributeName = %v, want %v", c.AttributeName, attrName)
 		}
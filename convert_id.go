@@ -1,6 +1,7 @@
 package brewerydb
 
 import (
+	"context"
 	"strconv"
 	"strings"
 )
@@ -27,6 +28,11 @@ const (
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/convertid_index#1
 func (cs *ConvertIDService) ConvertIDs(t ConvertType, oldIDs ...int) (map[int]string, error) {
+	return cs.ConvertIDsContext(context.Background(), t, oldIDs...)
+}
+
+// ConvertIDsContext is like ConvertIDs but uses the given Context for the request.
+func (cs *ConvertIDService) ConvertIDsContext(ctx context.Context, t ConvertType, oldIDs ...int) (map[int]string, error) {
 	// POST: /convertid
 
 	var ids []string
@@ -39,19 +45,15 @@ func (cs *ConvertIDService) ConvertIDs(t ConvertType, oldIDs ...int) (map[int]st
 		IDs  string `url:"ids"`
 	}{string(t), strings.Join(ids, ",")}
 
-	req, err := cs.c.NewRequest("POST", "/convertid", &q)
+	req, err := cs.c.NewRequestWithContext(ctx, "POST", "/convertid", &q)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		Status string
-		Data   []struct {
-			OldID int
-			NewID string
-		}
-		Message string
-	}{}
+	var resp Envelope[[]struct {
+		OldID int
+		NewID string
+	}]
 	if err := cs.c.Do(req, &resp); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,115 @@
+package brewerydb
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// Layouts of the dates and times used by BreweryDB.
+const (
+	TimestampLayout = "2006-01-02 15:04:05"
+	DateLayout      = "2006-01-02"
+	YearLayout      = "2006"
+)
+
+// Timestamp is a date and time reported by BreweryDB, e.g. a CreateDate
+// or UpdateDate, in the form "2006-01-02 15:04:05" and assumed to be UTC.
+// The zero Timestamp means the time is unknown.
+type Timestamp struct {
+	time.Time
+}
+
+// Date is a calendar date in the form "2006-01-02", e.g. an Event's StartDate.
+type Date struct {
+	time.Time
+}
+
+// Year is a calendar year in the form "2006", e.g. a Location's YearOpened.
+type Year struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes a Timestamp, allowing null or an empty string.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	return unmarshalTime(data, TimestampLayout, &t.Time)
+}
+
+// MarshalJSON encodes a Timestamp in BreweryDB's format.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return marshalTime(t.Time, TimestampLayout)
+}
+
+// EncodeValues adds the Timestamp to the given url.Values for the given
+// key, unless it is zero.
+func (t Timestamp) EncodeValues(key string, v *url.Values) error {
+	return encodeTime(t.Time, TimestampLayout, key, v)
+}
+
+// UnmarshalJSON decodes a Date, allowing null or an empty string.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalTime(data, DateLayout, &d.Time)
+}
+
+// MarshalJSON encodes a Date in BreweryDB's format.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return marshalTime(d.Time, DateLayout)
+}
+
+// EncodeValues adds the Date to the given url.Values for the given key,
+// unless it is zero.
+func (d Date) EncodeValues(key string, v *url.Values) error {
+	return encodeTime(d.Time, DateLayout, key, v)
+}
+
+// UnmarshalJSON decodes a Year, allowing null or an empty string.
+func (y *Year) UnmarshalJSON(data []byte) error {
+	return unmarshalTime(data, YearLayout, &y.Time)
+}
+
+// MarshalJSON encodes a Year in BreweryDB's format.
+func (y Year) MarshalJSON() ([]byte, error) {
+	return marshalTime(y.Time, YearLayout)
+}
+
+// EncodeValues adds the Year to the given url.Values for the given key,
+// unless it is zero.
+func (y Year) EncodeValues(key string, v *url.Values) error {
+	return encodeTime(y.Time, YearLayout, key, v)
+}
+
+func unmarshalTime(data []byte, layout string, t *time.Time) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = time.Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = time.Time{}
+		return nil
+	}
+	parsed, err := time.Parse(layout, s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+func marshalTime(t time.Time, layout string) ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(t.UTC().Format(layout))
+}
+
+func encodeTime(t time.Time, layout string, key string, v *url.Values) error {
+	if !t.IsZero() {
+		v.Set(key, t.UTC().Format(layout))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package brewerydb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		Created Timestamp
+		Start   Date
+		Opened  Year
+		Updated Timestamp
+		End     Date
+	}
+	data := `{"created":"2012-01-03 02:41:33","start":"2015-07-18","opened":"1980","updated":"","end":null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2012, 1, 3, 2, 41, 33, 0, time.UTC); !v.Created.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", v.Created, want)
+	}
+	if want := time.Date(2015, 7, 18, 0, 0, 0, 0, time.UTC); !v.Start.Equal(want) {
+		t.Errorf("Date = %v, want %v", v.Start, want)
+	}
+	if v.Opened.Year() != 1980 {
+		t.Errorf("Year = %v, want 1980", v.Opened)
+	}
+	if !v.Updated.IsZero() || !v.End.IsZero() {
+		t.Errorf("empty values decoded as %v, %v", v.Updated, v.End)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Created":"2012-01-03 02:41:33","Start":"2015-07-18","Opened":"1980","Updated":"","End":""}`; string(out) != want {
+		t.Errorf("JSON = %s, want %s", out, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"created":"yesterday"}`), &v); err == nil {
+		t.Error("expected error decoding invalid Timestamp")
+	}
+}
+
+func TestTimeQueryValues(t *testing.T) {
+	since := time.Date(2015, 7, 18, 12, 0, 0, 0, time.UTC)
+	q, err := query.Values(&BeerListRequest{Since: since})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := q.Get("since"); got != "1437220800" {
+		t.Errorf("since = %q, want %q", got, "1437220800")
+	}
+
+	q, err = query.Values(&Event{StartDate: Date{since}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := q.Get("startDate"); got != "2015-07-18" {
+		t.Errorf("startDate = %q, want %q", got, "2015-07-18")
+	}
+	if _, ok := q["endDate"]; ok {
+		t.Error("zero endDate encoded")
+	}
+
+	q, err = query.Values(&BeerListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := q["since"]; ok {
+		t.Error("zero since encoded")
+	}
+}
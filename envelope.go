@@ -0,0 +1,73 @@
+package brewerydb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Envelope is the JSON object BreweryDB wraps around every response.
+// Endpoints not covered by a service can be decoded into an Envelope
+// using NewRequest and Do:
+//
+//	var resp Envelope[[]Beer]
+//	req, _ := c.NewRequest("GET", "/brewery/"+id+"/beers", nil)
+//	err := c.Do(req, &resp)
+//
+// Service methods return only the Data of an Envelope; use WithResponseInfo
+// to read its Status and Message.
+type Envelope[T any] struct {
+	Status  string
+	Message string
+	Data    T
+}
+
+// Page is a single "page" of a paginated list of T, along with the
+// position of the page within the list.
+//
+// The list types returned by services, e.g. BeerList, are Pages. Their
+// items, formerly in fields named after the type such as BeerList.Beers,
+// are in Data.
+type Page[T any] struct {
+	CurrentPage   int
+	NumberOfPages int
+	TotalResults  int
+	Status        string
+	Message       string
+	Data          []T `json:"data"`
+}
+
+// ResponseInfo is the Status and Message of a BreweryDB response envelope,
+// e.g. the message confirming that a Beer was updated or deleted.
+type ResponseInfo struct {
+	Status  string
+	Message string
+}
+
+type responseInfoKey struct{}
+
+// WithResponseInfo returns a copy of ctx in which the Client records the
+// envelope of each successful response into info. It gives access to the
+// Status and Message of methods that return only their data, or only an
+// error:
+//
+//	var info brewerydb.ResponseInfo
+//	err := c.Beer.DeleteContext(brewerydb.WithResponseInfo(ctx, &info), "o9TSOv")
+//	fmt.Println(info.Message)
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoKey{}, info)
+}
+
+// recordResponseInfo decodes the envelope of the given response body into
+// the ResponseInfo associated with ctx, if any.
+func recordResponseInfo(ctx context.Context, body []byte) {
+	if info := responseInfo(ctx); info != nil {
+		*info = ResponseInfo{}
+		json.Unmarshal(body, info)
+	}
+}
+
+// responseInfo returns the ResponseInfo associated with ctx, if any.
+func responseInfo(ctx context.Context) *ResponseInfo {
+	info, _ := ctx.Value(responseInfoKey{}).(*ResponseInfo)
+	return info
+}
@@ -0,0 +1,86 @@
+package brewerydb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestEnvelope(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/brewery/o9TSOv/beers", func(w http.ResponseWriter, r *http.Request) {
+		checkMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status":"success","message":"Request Successful","data":[{"id":"jmGoBA","name":"Flying Dog Raging Bitch"}]}`)
+	})
+
+	req, err := client.NewRequest("GET", "/brewery/o9TSOv/beers", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp Envelope[[]Beer]
+	if err := client.Do(req, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != "success" || resp.Message != "Request Successful" {
+		t.Errorf("Status, Message = %q, %q", resp.Status, resp.Message)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ID != "jmGoBA" {
+		t.Errorf("Data = %+v", resp.Data)
+	}
+}
+
+func TestPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/styles", func(w http.ResponseWriter, r *http.Request) {
+		checkMethod(t, r, "GET")
+		fmt.Fprint(w, `{"currentPage":2,"numberOfPages":3,"totalResults":120,"status":"success","message":"READ ONLY MODE","data":[{"id":15}]}`)
+	})
+
+	l, err := client.Style.List(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.CurrentPage != 2 || l.NumberOfPages != 3 || l.TotalResults != 120 {
+		t.Errorf("page = %d of %d (%d results)", l.CurrentPage, l.NumberOfPages, l.TotalResults)
+	}
+	if l.Status != "success" || l.Message != "READ ONLY MODE" {
+		t.Errorf("Status, Message = %q, %q", l.Status, l.Message)
+	}
+	if len(l.Data) != 1 || l.Data[0].ID != 15 {
+		t.Errorf("Data = %+v", l.Data)
+	}
+}
+
+func TestResponseInfo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beer/o9TSOv", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"status":"success","message":"Request Successful","data":{"id":"o9TSOv"}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"status":"success","message":"Beer o9TSOv deleted"}`)
+		}
+	})
+
+	var info ResponseInfo
+	ctx := WithResponseInfo(context.Background(), &info)
+	if _, err := client.Beer.GetContext(ctx, "o9TSOv"); err != nil {
+		t.Fatal(err)
+	}
+	if want := (ResponseInfo{"success", "Request Successful"}); info != want {
+		t.Errorf("Get ResponseInfo = %+v, want %+v", info, want)
+	}
+	if err := client.Beer.DeleteContext(ctx, "o9TSOv"); err != nil {
+		t.Fatal(err)
+	}
+	if want := (ResponseInfo{"success", "Beer o9TSOv deleted"}); info != want {
+		t.Errorf("Delete ResponseInfo = %+v, want %+v", info, want)
+	}
+}
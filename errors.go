@@ -0,0 +1,115 @@
+package brewerydb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIError is returned by Client.Do when BreweryDB responds with a
+// non-2xx HTTP status or with a "failure" status in the response envelope.
+// The API Status and Message are decoded from the BreweryDB error envelope
+// when one is present in the response body.
+type APIError struct {
+	HTTPStatus int    // HTTP status code, e.g. 404
+	Status     string // API status, e.g. "failure"
+	Message    string // API errorMessage (or message)
+	Endpoint   string // BreweryDB endpoint, e.g. "/beer/o9TSOv"
+	Method     string // HTTP method
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.HTTPStatus)
+	}
+	return fmt.Sprintf("%s %s: HTTP Error %d: %s", e.Method, e.Endpoint, e.HTTPStatus, msg)
+}
+
+// errorEnvelope contains the fields of a BreweryDB response envelope
+// that describe an error.
+type errorEnvelope struct {
+	Status       string
+	ErrorMessage string
+	Message      string
+}
+
+// newAPIError builds an APIError from the given response and its body,
+// which may contain a BreweryDB error envelope. baseURL is the API base URL
+// the request was sent to.
+func newAPIError(resp *http.Response, body []byte, baseURL string) *APIError {
+	e := &APIError{HTTPStatus: resp.StatusCode}
+	if req := resp.Request; req != nil {
+		e.Method = req.Method
+		e.Endpoint = endpointPath(req.URL, baseURL)
+	}
+
+	var env errorEnvelope
+	if json.Unmarshal(body, &env) == nil {
+		e.Status = env.Status
+		e.Message = env.ErrorMessage
+		if e.Message == "" {
+			e.Message = env.Message
+		}
+	}
+	return e
+}
+
+// checkFailure decodes the envelope in the given response body. It returns
+// the envelope, along with an APIError if it reports a "failure" status.
+func checkFailure(resp *http.Response, body []byte, baseURL string) (errorEnvelope, error) {
+	var env errorEnvelope
+	if json.Unmarshal(body, &env) != nil || env.Status != "failure" {
+		return env, nil
+	}
+	return env, newAPIError(resp, body, baseURL)
+}
+
+// endpointPath returns the BreweryDB endpoint of the given request URL,
+// i.e. its path without the API base path.
+func endpointPath(u *url.URL, baseURL string) string {
+	if base, err := url.Parse(baseURL); err == nil {
+		return strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/"))
+	}
+	return u.Path
+}
+
+// apiErrorStatus reports the HTTP status of err if it is (or wraps) an APIError.
+func apiErrorStatus(err error) (int, bool) {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e.HTTPStatus, true
+	}
+	return 0, false
+}
+
+// IsNotFound reports whether err is an APIError for a missing resource.
+func IsNotFound(err error) bool {
+	status, ok := apiErrorStatus(err)
+	return ok && status == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an APIError caused by a missing
+// or invalid API key.
+func IsUnauthorized(err error) bool {
+	status, ok := apiErrorStatus(err)
+	return ok && (status == http.StatusUnauthorized || status == http.StatusForbidden)
+}
+
+// IsRateLimited reports whether err is an APIError caused by exceeding
+// the API key's request limit.
+func IsRateLimited(err error) bool {
+	status, ok := apiErrorStatus(err)
+	return ok && status == http.StatusTooManyRequests
+}
+
+// IsPremiumRequired reports whether err is an APIError caused by
+// requesting an endpoint or parameter reserved for premium members.
+func IsPremiumRequired(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && strings.Contains(strings.ToLower(e.Message), "premium")
+}
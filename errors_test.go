@@ -0,0 +1,146 @@
+package brewerydb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const id = "o9TSOv"
+	const msg = "The endpoint you requested could not be found"
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"status":"failure","errorMessage":"%s"}`, msg)
+	})
+
+	_, err := client.Beer.Get(id)
+	var e *APIError
+	if !errors.As(err, &e) {
+		t.Fatalf("error = %#v, want *APIError", err)
+	}
+	if e.HTTPStatus != http.StatusNotFound {
+		t.Errorf("APIError.HTTPStatus = %d, want %d", e.HTTPStatus, http.StatusNotFound)
+	}
+	if e.Status != "failure" {
+		t.Errorf("APIError.Status = %q, want %q", e.Status, "failure")
+	}
+	if e.Message != msg {
+		t.Errorf("APIError.Message = %q, want %q", e.Message, msg)
+	}
+	if want := "/beer/" + id; e.Endpoint != want {
+		t.Errorf("APIError.Endpoint = %q, want %q", e.Endpoint, want)
+	}
+	if e.Method != "GET" {
+		t.Errorf("APIError.Method = %q, want %q", e.Method, "GET")
+	}
+	if strings.Contains(e.Error(), fakeKey) {
+		t.Errorf("APIError.Error() = %q contains API key", e.Error())
+	}
+	if !IsNotFound(err) {
+		t.Error("IsNotFound = false, want true")
+	}
+}
+
+func TestAPIErrorNoEnvelope(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	})
+
+	err := client.Heartbeat.Heartbeat()
+	var e *APIError
+	if !errors.As(err, &e) {
+		t.Fatalf("error = %#v, want *APIError", err)
+	}
+	if e.Status != "" || e.Message != "" {
+		t.Errorf("APIError = %+v, want empty Status and Message", e)
+	}
+	if want := "GET /heartbeat: HTTP Error 502: Bad Gateway"; e.Error() != want {
+		t.Errorf("APIError.Error() = %q, want %q", e.Error(), want)
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	premium := &APIError{HTTPStatus: http.StatusUnauthorized, Message: "You must be a Premium member to use this parameter"}
+	tests := []struct {
+		err                                           error
+		notFound, unauthorized, limited, needsPremium bool
+	}{
+		{&APIError{HTTPStatus: http.StatusNotFound}, true, false, false, false},
+		{&APIError{HTTPStatus: http.StatusUnauthorized}, false, true, false, false},
+		{&APIError{HTTPStatus: http.StatusTooManyRequests}, false, false, true, false},
+		{premium, false, true, false, true},
+		{fmt.Errorf("wrapped: %w", premium), false, true, false, true},
+		{errors.New("HTTP Error 404"), false, false, false, false},
+		{nil, false, false, false, false},
+	}
+	for _, tt := range tests {
+		if v := IsNotFound(tt.err); v != tt.notFound {
+			t.Errorf("IsNotFound(%v) = %v, want %v", tt.err, v, tt.notFound)
+		}
+		if v := IsUnauthorized(tt.err); v != tt.unauthorized {
+			t.Errorf("IsUnauthorized(%v) = %v, want %v", tt.err, v, tt.unauthorized)
+		}
+		if v := IsRateLimited(tt.err); v != tt.limited {
+			t.Errorf("IsRateLimited(%v) = %v, want %v", tt.err, v, tt.limited)
+		}
+		if v := IsPremiumRequired(tt.err); v != tt.needsPremium {
+			t.Errorf("IsPremiumRequired(%v) = %v, want %v", tt.err, v, tt.needsPremium)
+		}
+	}
+}
+
+func TestWriteFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const msg = "You do not have permission to edit this entity"
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status":"failure","errorMessage":"%s"}`, msg)
+	})
+
+	writes := map[string]func() error{
+		"Beer.Update":         func() error { return client.Beer.Update("o9TSOv", makeTestBeer()) },
+		"Beer.Delete":         func() error { return client.Beer.Delete("o9TSOv") },
+		"Beer.AddAdjunct":     func() error { return client.Beer.AddAdjunct("o9TSOv", 1) },
+		"Beer.Add":            func() error { _, err := client.Beer.Add(makeTestBeer()); return err },
+		"Brewery.Update":      func() error { return client.Brewery.Update("jmGoBA", &Brewery{}) },
+		"Brewery.DeleteGuild": func() error { return client.Brewery.DeleteGuild("jmGoBA", "k2jMtH") },
+		"Event.Delete":        func() error { return client.Event.Delete("cJio9R") },
+		"Event.AddBeer":       func() error { return client.Event.AddBeer("cJio9R", "o9TSOv", nil) },
+		"Guild.Update":        func() error { return client.Guild.Update("k2jMtH", &Guild{}) },
+		"Location.Delete":     func() error { return client.Location.Delete("z9H9uv") },
+	}
+	for name, write := range writes {
+		err := write()
+		var e *APIError
+		if !errors.As(err, &e) {
+			t.Errorf("%s error = %#v, want *APIError", name, err)
+			continue
+		}
+		if e.HTTPStatus != http.StatusOK || e.Status != "failure" || e.Message != msg {
+			t.Errorf("%s APIError = %+v, want failure with message %q", name, e, msg)
+		}
+	}
+}
+
+func TestWriteSuccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"success","message":"Beer updated"}`)
+	})
+
+	if err := client.Beer.Update("o9TSOv", makeTestBeer()); err != nil {
+		t.Fatal(err)
+	}
+}
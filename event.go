@@ -1,8 +1,11 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // EventService provides access to the BreweryDB Event API.
@@ -31,8 +34,8 @@ type Event struct {
 	ID              string    `url:"-"`
 	Name            string    `url:"name"`
 	Type            EventType `url:"type"`
-	StartDate       string    `url:"startDate"` // YYYY-MM-DD
-	EndDate         string    `url:"endDate"`   // YYYY-MM-DD
+	StartDate       Date      `url:"startDate"` // YYYY-MM-DD
+	EndDate         Date      `url:"endDate"`   // YYYY-MM-DD
 	Description     string    `url:"description,omitempty"`
 	Year            string    `url:"year,omitempty"`
 	Time            string    `url:"time,omitempty"`
@@ -53,8 +56,8 @@ type Event struct {
 	Status          string    `url:"-"`
 	StatusDisplay   string    `url:"-"`
 	Country         Country   `url:"-"`
-	CreateDate      string    `url:"-"`
-	UpdateDate      string    `url:"-"`
+	CreateDate      Timestamp `url:"-"`
+	UpdateDate      Timestamp `url:"-"`
 }
 
 // EventOrder specifies the ordering of an EventList.
@@ -75,12 +78,7 @@ const (
 )
 
 // EventList represents a single "page" containing a slice of Events.
-type EventList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Events        []Event `json:"data"`
-}
+type EventList = Page[Event]
 
 // EventListRequest contains options for specifying the kinds of Events desired.
 // Non-Premium users must set one of the following: year, name, type, locality, region
@@ -93,7 +91,7 @@ type EventListRequest struct {
 	Locality       string     `url:"locality,omitempty"` // e.g. US city
 	Region         string     `url:"region,omitempty"`   // e.g. US state
 	CountryISOCode string     `url:"countryIsoCode,omitempty"`
-	Since          int        `url:"since,omitempty"` // Unix timestamp
+	Since          time.Time  `url:"since,omitempty,unix"` // Max 30 days ago
 	Status         string     `url:"status,omitempty"`
 	HasImages      YesNo      `url:"hasImages,omitempty"`
 	Order          EventOrder `url:"order,omitempty"`
@@ -103,9 +101,14 @@ type EventListRequest struct {
 // List returns an EventList containing a "page" of Events.
 // For non-premium members, one of Year, Name, Type, Locality or Region must be set.
 func (es *EventService) List(q *EventListRequest) (el EventList, err error) {
+	return es.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (es *EventService) ListContext(ctx context.Context, q *EventListRequest) (el EventList, err error) {
 	// GET: /events
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/events", q)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/events", q)
 	if err != nil {
 		return
 	}
@@ -114,25 +117,85 @@ func (es *EventService) List(q *EventListRequest) (el EventList, err error) {
 	return
 }
 
+// All returns an iterator over the Events on every page matching the
+// given EventListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (es *EventService) All(q *EventListRequest) iter.Seq2[Event, error] {
+	return es.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (es *EventService) AllContext(ctx context.Context, q *EventListRequest) iter.Seq2[Event, error] {
+	var req EventListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Event, int, error) {
+		r := req
+		r.Page = page
+		l, err := es.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Events on every page matching the given
+// EventListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (es *EventService) FetchAll(q *EventListRequest, workers int) ([]Event, error) {
+	return es.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (es *EventService) FetchAllContext(ctx context.Context, q *EventListRequest, workers int) ([]Event, error) {
+	var req EventListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Event, int, error) {
+		r := req
+		r.Page = page
+		l, err := es.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get retrieves a single event with the given eventID.
 func (es *EventService) Get(eventID string) (e Event, err error) {
+	return es.GetContext(context.Background(), eventID)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (es *EventService) GetContext(ctx context.Context, eventID string) (e Event, err error) {
 	// GET: /event/:eventID
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID, nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID, nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Event
-		Message string
-	}{}
+	var resp Envelope[Event]
 
 	err = es.c.Do(req, &resp)
 	return resp.Data, err
 }
 
+// GetMany queries for the Events with the given IDs, which are requested
+// concurrently in batches of 10. It returns the Events found, in the order
+// of ids, and the IDs that were not found.
+func (es *EventService) GetMany(ids []string) ([]Event, []string, error) {
+	return es.GetManyContext(context.Background(), ids)
+}
+
+// GetManyContext is like GetMany but uses the given Context for the requests.
+func (es *EventService) GetManyContext(ctx context.Context, ids []string) ([]Event, []string, error) {
+	id := func(v Event) string { return v.ID }
+	return getMany(ctx, ids, id, func(ctx context.Context, ids string) ([]Event, error) {
+		l, err := es.ListContext(ctx, &EventListRequest{Page: 1, IDs: ids})
+		return l.Data, err
+	})
+}
+
 // Add adds an Event to the BreweryDB and returns its new ID.
 // The following **must** be set in the Event:
 //
@@ -141,22 +204,21 @@ func (es *EventService) Get(eventID string) (e Event, err error) {
 // - StartDate (YYYY-MM-DD)
 // - EndDate (YYYY-MM-DD)
 func (es *EventService) Add(e *Event) (string, error) {
+	return es.AddContext(context.Background(), e)
+}
+
+// AddContext is like Add but uses the given Context for the request.
+func (es *EventService) AddContext(ctx context.Context, e *Event) (string, error) {
 	// POST: /events
 	if e == nil {
 		return "", fmt.Errorf("nil Event")
 	}
-	req, err := es.c.NewRequest("POST", "/events", e)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/events", e)
 	if err != nil {
 		return "", err
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID string
-		}
-		Message string
-	}{}
+	var resp Envelope[struct{ ID string }]
 
 	err = es.c.Do(req, &resp)
 	return resp.Data.ID, err
@@ -164,23 +226,32 @@ func (es *EventService) Add(e *Event) (string, error) {
 
 // Update updates the Event with the given eventID to match the given Event.
 func (es *EventService) Update(eventID string, e *Event) error {
+	return es.UpdateContext(context.Background(), eventID, e)
+}
+
+// UpdateContext is like Update but uses the given Context for the request.
+func (es *EventService) UpdateContext(ctx context.Context, eventID string, e *Event) error {
 	// PUT: /event/:eventID
 	if e == nil {
 		return fmt.Errorf("nil Event")
 	}
-	req, err := es.c.NewRequest("PUT", "/event/"+eventID, e)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", "/event/"+eventID, e)
 	if err != nil {
 		return err
 	}
 
-	// TODO: return any response?
 	return es.c.Do(req, nil)
 }
 
 // Delete removes the Event with the given eventID.
 func (es *EventService) Delete(eventID string) error {
+	return es.DeleteContext(context.Background(), eventID)
+}
+
+// DeleteContext is like Delete but uses the given Context for the request.
+func (es *EventService) DeleteContext(ctx context.Context, eventID string) error {
 	// DELETE: /event/:eventID
-	req, err := es.c.NewRequest("DELETE", "/event/"+eventID, nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", "/event/"+eventID, nil)
 	if err != nil {
 		return err
 	}
@@ -190,57 +261,64 @@ func (es *EventService) Delete(eventID string) error {
 
 // AwardCategory represents a category of award for an Event.
 type AwardCategory struct {
-	ID          int    `url:"-"`
-	Name        string `url:"name"` // required for adding/updating AwardCategories
-	Description string `url:"description"`
-	Image       string `url:"image"` // base64
-	CreateDate  string `url:"-"`
-	UpdateDate  string `url:"-"`
+	ID          int       `url:"-"`
+	Name        string    `url:"name"` // required for adding/updating AwardCategories
+	Description string    `url:"description"`
+	Image       string    `url:"image"` // base64
+	CreateDate  Timestamp `url:"-"`
+	UpdateDate  Timestamp `url:"-"`
 }
 
 // ListAwardCategories returns a slice of all AwardCategories for the given Event.
 func (es *EventService) ListAwardCategories(eventID string) (al []AwardCategory, err error) {
+	return es.ListAwardCategoriesContext(context.Background(), eventID)
+}
+
+// ListAwardCategoriesContext is like ListAwardCategories but uses the given Context for the request.
+func (es *EventService) ListAwardCategoriesContext(ctx context.Context, eventID string) (al []AwardCategory, err error) {
 	// GET: /event/:eventId/awardcategories
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/awardcategories", nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/awardcategories", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []AwardCategory
-		Message string
-	}{}
+	var resp Envelope[[]AwardCategory]
 	err = es.c.Do(req, &resp)
-	return resp.Data, nil
+	return resp.Data, err
 }
 
 // GetAwardCategory retrieves the specified AwardCategory for the given Event.
 func (es *EventService) GetAwardCategory(eventID string, awardCategoryID int) (a AwardCategory, err error) {
+	return es.GetAwardCategoryContext(context.Background(), eventID, awardCategoryID)
+}
+
+// GetAwardCategoryContext is like GetAwardCategory but uses the given Context for the request.
+func (es *EventService) GetAwardCategoryContext(ctx context.Context, eventID string, awardCategoryID int) (a AwardCategory, err error) {
 	// GET: /event/:eventId/awardcategory/:awardcategoryId
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, awardCategoryID), nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, awardCategoryID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    AwardCategory
-		Message string
-	}{}
+	var resp Envelope[AwardCategory]
 	err = es.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // AddAwardCategory adds a new AwardCategory to the given Event.
 func (es *EventService) AddAwardCategory(eventID string, a *AwardCategory) error {
+	return es.AddAwardCategoryContext(context.Background(), eventID, a)
+}
+
+// AddAwardCategoryContext is like AddAwardCategory but uses the given Context for the request.
+func (es *EventService) AddAwardCategoryContext(ctx context.Context, eventID string, a *AwardCategory) error {
 	// POST: /event/:eventId/awardcategories
 	if a == nil {
 		return fmt.Errorf("nil AwardCategory")
 	}
-	req, err := es.c.NewRequest("POST", "/event/"+eventID+"/awardcategories", a)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/event/"+eventID+"/awardcategories", a)
 	if err != nil {
 		return err
 	}
@@ -250,11 +328,16 @@ func (es *EventService) AddAwardCategory(eventID string, a *AwardCategory) error
 
 // UpdateAwardCategory updates an AwardCategory	for the given Event.
 func (es *EventService) UpdateAwardCategory(eventID string, a *AwardCategory) error {
+	return es.UpdateAwardCategoryContext(context.Background(), eventID, a)
+}
+
+// UpdateAwardCategoryContext is like UpdateAwardCategory but uses the given Context for the request.
+func (es *EventService) UpdateAwardCategoryContext(ctx context.Context, eventID string, a *AwardCategory) error {
 	// PUT: /event/:eventId/awardcategory/:awardcategoryId
 	if a == nil {
 		return fmt.Errorf("nil AwardCategory")
 	}
-	req, err := es.c.NewRequest("PUT", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, a.ID), a)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, a.ID), a)
 	if err != nil {
 		return err
 	}
@@ -264,8 +347,13 @@ func (es *EventService) UpdateAwardCategory(eventID string, a *AwardCategory) er
 
 // DeleteAwardCategory removes an AwardCategory from the given Event.
 func (es *EventService) DeleteAwardCategory(eventID string, awardCategoryID int) error {
+	return es.DeleteAwardCategoryContext(context.Background(), eventID, awardCategoryID)
+}
+
+// DeleteAwardCategoryContext is like DeleteAwardCategory but uses the given Context for the request.
+func (es *EventService) DeleteAwardCategoryContext(ctx context.Context, eventID string, awardCategoryID int) error {
 	// DELETE: /event/:eventId/awardcategory/:awardcategoryId
-	req, err := es.c.NewRequest("DELETE", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, awardCategoryID), nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/event/%s/awardcategory/%d", eventID, awardCategoryID), nil)
 	if err != nil {
 		return err
 	}
@@ -275,57 +363,64 @@ func (es *EventService) DeleteAwardCategory(eventID string, awardCategoryID int)
 
 // AwardPlace represents an award location.
 type AwardPlace struct {
-	ID          int    `url:"-"`
-	Name        string `url:"name"` // required for adding/updating AwardPlaces
-	Description string `url:"description"`
-	Image       string `url:"image"` // base64
-	CreateDate  string `url:"-"`
-	UpdateDate  string `url:"-"`
+	ID          int       `url:"-"`
+	Name        string    `url:"name"` // required for adding/updating AwardPlaces
+	Description string    `url:"description"`
+	Image       string    `url:"image"` // base64
+	CreateDate  Timestamp `url:"-"`
+	UpdateDate  Timestamp `url:"-"`
 }
 
 // ListAwardPlaces returns a slice of all AwardPlaces for the given Event.
 func (es *EventService) ListAwardPlaces(eventID string) (al []AwardPlace, err error) {
+	return es.ListAwardPlacesContext(context.Background(), eventID)
+}
+
+// ListAwardPlacesContext is like ListAwardPlaces but uses the given Context for the request.
+func (es *EventService) ListAwardPlacesContext(ctx context.Context, eventID string) (al []AwardPlace, err error) {
 	// GET: /event/:eventId/awardplaces
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/awardplaces", nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/awardplaces", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []AwardPlace
-		Message string
-	}{}
+	var resp Envelope[[]AwardPlace]
 	err = es.c.Do(req, &resp)
-	return resp.Data, nil
+	return resp.Data, err
 }
 
 // GetAwardPlace retrieves the specified AwardPlace for the given Event.
 func (es *EventService) GetAwardPlace(eventID string, awardPlaceID int) (a AwardPlace, err error) {
+	return es.GetAwardPlaceContext(context.Background(), eventID, awardPlaceID)
+}
+
+// GetAwardPlaceContext is like GetAwardPlace but uses the given Context for the request.
+func (es *EventService) GetAwardPlaceContext(ctx context.Context, eventID string, awardPlaceID int) (a AwardPlace, err error) {
 	// GET: /event/:eventId/awardplace/:awardplaceId
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", fmt.Sprintf("/event/%s/awardplace/%d", eventID, awardPlaceID), nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/event/%s/awardplace/%d", eventID, awardPlaceID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    AwardPlace
-		Message string
-	}{}
+	var resp Envelope[AwardPlace]
 	err = es.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // AddAwardPlace adds a new AwardPlace to the given Event.
 func (es *EventService) AddAwardPlace(eventID string, a *AwardPlace) error {
+	return es.AddAwardPlaceContext(context.Background(), eventID, a)
+}
+
+// AddAwardPlaceContext is like AddAwardPlace but uses the given Context for the request.
+func (es *EventService) AddAwardPlaceContext(ctx context.Context, eventID string, a *AwardPlace) error {
 	// POST: /event/:eventId/awardplaces
 	if a == nil {
 		return fmt.Errorf("nil AwardPlace")
 	}
-	req, err := es.c.NewRequest("POST", "/event/"+eventID+"/awardplaces", a)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/event/"+eventID+"/awardplaces", a)
 	if err != nil {
 		return err
 	}
@@ -335,11 +430,16 @@ func (es *EventService) AddAwardPlace(eventID string, a *AwardPlace) error {
 
 // UpdateAwardPlace updates an AwardPlace for the given Event.
 func (es *EventService) UpdateAwardPlace(eventID string, a *AwardPlace) error {
+	return es.UpdateAwardPlaceContext(context.Background(), eventID, a)
+}
+
+// UpdateAwardPlaceContext is like UpdateAwardPlace but uses the given Context for the request.
+func (es *EventService) UpdateAwardPlaceContext(ctx context.Context, eventID string, a *AwardPlace) error {
 	// PUT: /event/:eventId/awardplace/:awardplaceId
 	if a == nil {
 		return fmt.Errorf("nil AwardPlace")
 	}
-	req, err := es.c.NewRequest("PUT", fmt.Sprintf("/event/%s/awardplace/%d", eventID, a.ID), a)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/event/%s/awardplace/%d", eventID, a.ID), a)
 	if err != nil {
 		return err
 	}
@@ -349,8 +449,13 @@ func (es *EventService) UpdateAwardPlace(eventID string, a *AwardPlace) error {
 
 // DeleteAwardPlace removes an AwardPlace from the given Event.
 func (es *EventService) DeleteAwardPlace(eventID string, awardPlaceID int) error {
+	return es.DeleteAwardPlaceContext(context.Background(), eventID, awardPlaceID)
+}
+
+// DeleteAwardPlaceContext is like DeleteAwardPlace but uses the given Context for the request.
+func (es *EventService) DeleteAwardPlaceContext(ctx context.Context, eventID string, awardPlaceID int) error {
 	// DELETE: /event/:eventId/awardplace/:awardplaceId
-	req, err := es.c.NewRequest("DELETE", fmt.Sprintf("/event/%s/awardplace/%d", eventID, awardPlaceID), nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/event/%s/awardplace/%d", eventID, awardPlaceID), nil)
 	if err != nil {
 		return err
 	}
@@ -369,9 +474,14 @@ type EventBeersRequest struct {
 
 // ListBeers returns a page of Beers for the given Event.
 func (es *EventService) ListBeers(eventID string, q *EventBeersRequest) (bl BeerList, err error) {
+	return es.ListBeersContext(context.Background(), eventID, q)
+}
+
+// ListBeersContext is like ListBeers but uses the given Context for the request.
+func (es *EventService) ListBeersContext(ctx context.Context, eventID string, q *EventBeersRequest) (bl BeerList, err error) {
 	// GET: /event/:eventID/beers
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/beers", q)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/beers", q)
 	if err != nil {
 		return
 	}
@@ -382,18 +492,19 @@ func (es *EventService) ListBeers(eventID string, q *EventBeersRequest) (bl Beer
 
 // GetBeer retrieves the Beer with the given ID for the given Event.
 func (es *EventService) GetBeer(eventID, beerID string) (b Beer, err error) {
+	return es.GetBeerContext(context.Background(), eventID, beerID)
+}
+
+// GetBeerContext is like GetBeer but uses the given Context for the request.
+func (es *EventService) GetBeerContext(ctx context.Context, eventID, beerID string) (b Beer, err error) {
 	// GET: /event/:eventId/beer/:beerId
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/beer/"+beerID, nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/beer/"+beerID, nil)
 	if err != nil {
 		return
 	}
 
-	eventBeerResp := struct {
-		Status  string
-		Data    Beer
-		Message string
-	}{}
+	var eventBeerResp Envelope[Beer]
 	err = es.c.Do(req, &eventBeerResp)
 	return eventBeerResp.Data, err
 }
@@ -413,6 +524,11 @@ type eventAddBeerRequest struct {
 
 // AddBeer adds the Beer with the given ID to the given Event.
 func (es *EventService) AddBeer(eventID, beerID string, q *EventChangeBeerRequest) error {
+	return es.AddBeerContext(context.Background(), eventID, beerID, q)
+}
+
+// AddBeerContext is like AddBeer but uses the given Context for the request.
+func (es *EventService) AddBeerContext(ctx context.Context, eventID, beerID string, q *EventChangeBeerRequest) error {
 	// POST: /event/:eventId/beers
 	var params *eventAddBeerRequest
 	if q != nil {
@@ -420,7 +536,7 @@ func (es *EventService) AddBeer(eventID, beerID string, q *EventChangeBeerReques
 	} else {
 		params = &eventAddBeerRequest{BeerID: beerID}
 	}
-	req, err := es.c.NewRequest("POST", "/event/"+eventID+"/beers", params)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/event/"+eventID+"/beers", params)
 	if err != nil {
 		return err
 	}
@@ -429,11 +545,16 @@ func (es *EventService) AddBeer(eventID, beerID string, q *EventChangeBeerReques
 
 // UpdateBeer updates the Beer with the given ID for the given Event.
 func (es *EventService) UpdateBeer(eventID, beerID string, q *EventChangeBeerRequest) error {
+	return es.UpdateBeerContext(context.Background(), eventID, beerID, q)
+}
+
+// UpdateBeerContext is like UpdateBeer but uses the given Context for the request.
+func (es *EventService) UpdateBeerContext(ctx context.Context, eventID, beerID string, q *EventChangeBeerRequest) error {
 	// PUT: /event/:eventId/beer/:beerId
 	if q == nil {
 		q = &EventChangeBeerRequest{}
 	}
-	req, err := es.c.NewRequest("PUT", "/event/"+eventID+"/beer/"+beerID, q)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", "/event/"+eventID+"/beer/"+beerID, q)
 	if err != nil {
 		return err
 	}
@@ -442,8 +563,13 @@ func (es *EventService) UpdateBeer(eventID, beerID string, q *EventChangeBeerReq
 
 // DeleteBeer removes the Beer with the given ID from the given Event.
 func (es *EventService) DeleteBeer(eventID, beerID string) error {
+	return es.DeleteBeerContext(context.Background(), eventID, beerID)
+}
+
+// DeleteBeerContext is like DeleteBeer but uses the given Context for the request.
+func (es *EventService) DeleteBeerContext(ctx context.Context, eventID, beerID string) error {
 	// DELETE: /event/:eventId/beer/:beerId
-	req, err := es.c.NewRequest("DELETE", "/event/"+eventID+"/beer/"+beerID, nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", "/event/"+eventID+"/beer/"+beerID, nil)
 	if err != nil {
 		return err
 	}
@@ -461,9 +587,14 @@ type EventBreweriesRequest struct {
 
 // ListBreweries returns a page of Breweries for the given Event.
 func (es *EventService) ListBreweries(eventID string, q *EventBreweriesRequest) (bl BreweryList, err error) {
+	return es.ListBreweriesContext(context.Background(), eventID, q)
+}
+
+// ListBreweriesContext is like ListBreweries but uses the given Context for the request.
+func (es *EventService) ListBreweriesContext(ctx context.Context, eventID string, q *EventBreweriesRequest) (bl BreweryList, err error) {
 	// GET: /event/:eventID/breweries
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/breweries", q)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/breweries", q)
 	if err != nil {
 		return
 	}
@@ -474,18 +605,19 @@ func (es *EventService) ListBreweries(eventID string, q *EventBreweriesRequest)
 
 // GetBrewery retrieves the Brewery with the given ID for the given Event.
 func (es *EventService) GetBrewery(eventID, breweryID string) (b Brewery, err error) {
+	return es.GetBreweryContext(context.Background(), eventID, breweryID)
+}
+
+// GetBreweryContext is like GetBrewery but uses the given Context for the request.
+func (es *EventService) GetBreweryContext(ctx context.Context, eventID, breweryID string) (b Brewery, err error) {
 	// GET: /event/:eventID/brewery/:breweryID
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/brewery/"+breweryID, nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/brewery/"+breweryID, nil)
 	if err != nil {
 		return
 	}
 
-	eventBreweryResp := struct {
-		Status  string
-		Data    Brewery
-		Message string
-	}{}
+	var eventBreweryResp Envelope[Brewery]
 	err = es.c.Do(req, &eventBreweryResp)
 	return eventBreweryResp.Data, err
 }
@@ -505,6 +637,11 @@ type eventAddBreweryRequest struct {
 
 // AddBrewery adds the Brewery with the given ID to the given Event.
 func (es *EventService) AddBrewery(eventID, breweryID string, q *EventChangeBreweryRequest) error {
+	return es.AddBreweryContext(context.Background(), eventID, breweryID, q)
+}
+
+// AddBreweryContext is like AddBrewery but uses the given Context for the request.
+func (es *EventService) AddBreweryContext(ctx context.Context, eventID, breweryID string, q *EventChangeBreweryRequest) error {
 	// POST: /event/:eventID/brewery/:breweryID
 	var params *eventAddBreweryRequest
 	if q != nil {
@@ -513,7 +650,7 @@ func (es *EventService) AddBrewery(eventID, breweryID string, q *EventChangeBrew
 		params = &eventAddBreweryRequest{BreweryID: breweryID}
 	}
 
-	req, err := es.c.NewRequest("POST", "/event/"+eventID+"/breweries", params)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/event/"+eventID+"/breweries", params)
 	if err != nil {
 		return err
 	}
@@ -522,11 +659,16 @@ func (es *EventService) AddBrewery(eventID, breweryID string, q *EventChangeBrew
 
 // UpdateBrewery updates the Brewery with the given ID for the given Event.
 func (es *EventService) UpdateBrewery(eventID, breweryID string, q *EventChangeBreweryRequest) error {
+	return es.UpdateBreweryContext(context.Background(), eventID, breweryID, q)
+}
+
+// UpdateBreweryContext is like UpdateBrewery but uses the given Context for the request.
+func (es *EventService) UpdateBreweryContext(ctx context.Context, eventID, breweryID string, q *EventChangeBreweryRequest) error {
 	// PUT: /event/:eventID/brewery/:breweryID
 	if q == nil {
 		q = &EventChangeBreweryRequest{}
 	}
-	req, err := es.c.NewRequest("PUT", "/event/"+eventID+"/brewery/"+breweryID, q)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", "/event/"+eventID+"/brewery/"+breweryID, q)
 	if err != nil {
 		return err
 	}
@@ -535,8 +677,13 @@ func (es *EventService) UpdateBrewery(eventID, breweryID string, q *EventChangeB
 
 // DeleteBrewery removes the Brewery with the given ID from the given Event.
 func (es *EventService) DeleteBrewery(eventID, breweryID string) error {
+	return es.DeleteBreweryContext(context.Background(), eventID, breweryID)
+}
+
+// DeleteBreweryContext is like DeleteBrewery but uses the given Context for the request.
+func (es *EventService) DeleteBreweryContext(ctx context.Context, eventID, breweryID string) error {
 	// DELETE: /event/:eventID/brewery/:breweryID
-	req, err := es.c.NewRequest("DELETE", "/event/"+eventID+"/brewery/"+breweryID, nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", "/event/"+eventID+"/brewery/"+breweryID, nil)
 	if err != nil {
 		return err
 	}
@@ -545,47 +692,54 @@ func (es *EventService) DeleteBrewery(eventID, breweryID string) error {
 
 // ListSocialAccounts returns a slice of all social media accounts associated with the given Event.
 func (es *EventService) ListSocialAccounts(eventID string) (sl []SocialAccount, err error) {
+	return es.ListSocialAccountsContext(context.Background(), eventID)
+}
+
+// ListSocialAccountsContext is like ListSocialAccounts but uses the given Context for the request.
+func (es *EventService) ListSocialAccountsContext(ctx context.Context, eventID string) (sl []SocialAccount, err error) {
 	// GET: /event/:eventId/socialaccounts
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", "/event/"+eventID+"/socialaccounts", nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", "/event/"+eventID+"/socialaccounts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []SocialAccount
-		Message string
-	}{}
+	var resp Envelope[[]SocialAccount]
 	err = es.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // GetSocialAccount retrieves the SocialAccount with the given ID for the given Event.
 func (es *EventService) GetSocialAccount(eventID string, socialAccountID int) (s SocialAccount, err error) {
+	return es.GetSocialAccountContext(context.Background(), eventID, socialAccountID)
+}
+
+// GetSocialAccountContext is like GetSocialAccount but uses the given Context for the request.
+func (es *EventService) GetSocialAccountContext(ctx context.Context, eventID string, socialAccountID int) (s SocialAccount, err error) {
 	// GET: /event/:eventId/socialaccount/:socialaccountId
 	var req *http.Request
-	req, err = es.c.NewRequest("GET", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, socialAccountID), nil)
+	req, err = es.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, socialAccountID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    SocialAccount
-		Message string
-	}{}
+	var resp Envelope[SocialAccount]
 	err = es.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // AddSocialAccount adds a new SocialAccount to the given Event.
 func (es *EventService) AddSocialAccount(eventID string, s *SocialAccount) error {
+	return es.AddSocialAccountContext(context.Background(), eventID, s)
+}
+
+// AddSocialAccountContext is like AddSocialAccount but uses the given Context for the request.
+func (es *EventService) AddSocialAccountContext(ctx context.Context, eventID string, s *SocialAccount) error {
 	// POST: /event/:eventId/socialaccounts
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := es.c.NewRequest("POST", "/event/"+eventID+"/socialaccounts", s)
+	req, err := es.c.NewRequestWithContext(ctx, "POST", "/event/"+eventID+"/socialaccounts", s)
 	if err != nil {
 		return err
 	}
@@ -595,11 +749,16 @@ func (es *EventService) AddSocialAccount(eventID string, s *SocialAccount) error
 
 // UpdateSocialAccount updates a SocialAccount for the given Event.
 func (es *EventService) UpdateSocialAccount(eventID string, s *SocialAccount) error {
+	return es.UpdateSocialAccountContext(context.Background(), eventID, s)
+}
+
+// UpdateSocialAccountContext is like UpdateSocialAccount but uses the given Context for the request.
+func (es *EventService) UpdateSocialAccountContext(ctx context.Context, eventID string, s *SocialAccount) error {
 	// PUT: /event/:eventId/socialaccount/:socialaccountId
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := es.c.NewRequest("PUT", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, s.ID), s)
+	req, err := es.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, s.ID), s)
 	if err != nil {
 		return err
 	}
@@ -609,8 +768,13 @@ func (es *EventService) UpdateSocialAccount(eventID string, s *SocialAccount) er
 
 // DeleteSocialAccount removes a SocialAccount from the given Event.
 func (es *EventService) DeleteSocialAccount(eventID string, socialAccountID int) error {
+	return es.DeleteSocialAccountContext(context.Background(), eventID, socialAccountID)
+}
+
+// DeleteSocialAccountContext is like DeleteSocialAccount but uses the given Context for the request.
+func (es *EventService) DeleteSocialAccountContext(ctx context.Context, eventID string, socialAccountID int) error {
 	// DELETE: /event/:eventId/socialaccount/:socialaccountId
-	req, err := es.c.NewRequest("DELETE", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, socialAccountID), nil)
+	req, err := es.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/event/%s/socialaccount/%d", eventID, socialAccountID), nil)
 	if err != nil {
 		return err
 	}
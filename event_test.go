@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestEventGet(t *testing.T) {
@@ -58,10 +59,10 @@ func TestEventList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(el.Events) <= 0 {
+	if len(el.Data) <= 0 {
 		t.Fatal("Expected >0 events")
 	}
-	for _, e := range el.Events {
+	for _, e := range el.Data {
 		if l := 6; l != len(e.ID) {
 			t.Fatalf("Event ID len = %d, wanted %d", len(e.ID), l)
 		}
@@ -78,8 +79,8 @@ func makeTestEvent() *Event {
 		ID:             "k2jMtH",
 		Name:           "Yellowstone Beer Fest",
 		Type:           EventFestival,
-		StartDate:      "2015-07-18",
-		EndDate:        "2015-07-18",
+		StartDate:      Date{time.Date(2015, 7, 18, 0, 0, 0, 0, time.UTC)},
+		EndDate:        Date{time.Date(2015, 7, 18, 0, 0, 0, 0, time.UTC)},
 		Description:    "Regional beer fest in Cody, Wyoming",
 		Year:           "2015",
 		Time:           "from 3:00 PM - 8:00 PM",
@@ -118,8 +119,8 @@ func TestEventAdd(t *testing.T) {
 
 		checkPostFormValue(t, r, "name", event.Name)
 		checkPostFormValue(t, r, "type", string(EventFestival))
-		checkPostFormValue(t, r, "startDate", event.StartDate)
-		checkPostFormValue(t, r, "endDate", event.EndDate)
+		checkPostFormValue(t, r, "startDate", "2015-07-18")
+		checkPostFormValue(t, r, "endDate", "2015-07-18")
 		checkPostFormValue(t, r, "description", event.Description)
 		checkPostFormValue(t, r, "time", event.Time)
 		checkPostFormValue(t, r, "time", event.Time)
@@ -176,8 +177,8 @@ func TestEventUpdate(t *testing.T) {
 
 		checkPostFormValue(t, r, "name", event.Name)
 		checkPostFormValue(t, r, "type", string(EventFestival))
-		checkPostFormValue(t, r, "startDate", event.StartDate)
-		checkPostFormValue(t, r, "endDate", event.EndDate)
+		checkPostFormValue(t, r, "startDate", "2015-07-18")
+		checkPostFormValue(t, r, "endDate", "2015-07-18")
 		checkPostFormValue(t, r, "description", event.Description)
 		checkPostFormValue(t, r, "time", event.Time)
 		checkPostFormValue(t, r, "time", event.Time)
@@ -647,11 +648,11 @@ func TestEventListBeer(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(bl.Beers) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 Beers")
 	}
 
-	for _, b := range bl.Beers {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Beer ID len = %d, wanted %d", len(b.ID), l)
 		}
@@ -842,11 +843,11 @@ func TestEventListBreweries(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(bl.Breweries) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 Breweries")
 	}
 
-	for _, b := range bl.Breweries {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Brewery ID len = %d, wanted %d", len(b.ID), l)
 		}
@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -28,18 +30,19 @@ type Feature struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/feature_index#1
 func (fs *FeatureService) Get() (f Feature, err error) {
+	return fs.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (fs *FeatureService) GetContext(ctx context.Context) (f Feature, err error) {
 	// GET: /featured
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", "/featured", nil)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", "/featured", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Feature
-		Message string
-	}{}
+	var resp Envelope[Feature]
 	err = fs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -53,20 +56,20 @@ type FeatureListRequest struct {
 }
 
 // FeatureList represents a single "page" containing a slice of Features.
-type FeatureList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Features      []Feature `json:"data"`
-}
+type FeatureList = Page[Feature]
 
 // List returns all Featured Beers and Breweries.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/feature_index#2
 func (fs *FeatureService) List(q *FeatureListRequest) (fl FeatureList, err error) {
+	return fs.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (fs *FeatureService) ListContext(ctx context.Context, q *FeatureListRequest) (fl FeatureList, err error) {
 	// GET: /features
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", "/features", q)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", "/features", q)
 	if err != nil {
 		return
 	}
@@ -75,23 +78,67 @@ func (fs *FeatureService) List(q *FeatureListRequest) (fl FeatureList, err error
 	return
 }
 
+// All returns an iterator over the Features on every page matching the
+// given FeatureListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (fs *FeatureService) All(q *FeatureListRequest) iter.Seq2[Feature, error] {
+	return fs.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (fs *FeatureService) AllContext(ctx context.Context, q *FeatureListRequest) iter.Seq2[Feature, error] {
+	var req FeatureListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Feature, int, error) {
+		r := req
+		r.Page = page
+		l, err := fs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Features on every page matching the given
+// FeatureListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (fs *FeatureService) FetchAll(q *FeatureListRequest, workers int) ([]Feature, error) {
+	return fs.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (fs *FeatureService) FetchAllContext(ctx context.Context, q *FeatureListRequest, workers int) ([]Feature, error) {
+	var req FeatureListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Feature, int, error) {
+		r := req
+		r.Page = page
+		l, err := fs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // ByWeek returns the Featured Beer and Brewery for the given
 // year and week number.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/feature_index#3
 func (fs *FeatureService) ByWeek(year, week int) (f Feature, err error) {
+	return fs.ByWeekContext(context.Background(), year, week)
+}
+
+// ByWeekContext is like ByWeek but uses the given Context for the request.
+func (fs *FeatureService) ByWeekContext(ctx context.Context, year, week int) (f Feature, err error) {
 	// GET: /feature/:year-week
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", fmt.Sprintf("/feature/%4d-%02d", year, week), nil)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/feature/%4d-%02d", year, week), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Feature
-		Message string
-	}{}
+	var resp Envelope[Feature]
 	err = fs.c.Do(req, &resp)
 	return resp.Data, err
 }
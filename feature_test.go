@@ -65,11 +65,11 @@ func TestFeatureList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(fl.Features) <= 0 {
+	if len(fl.Data) <= 0 {
 		t.Fatal("Expected >0 features")
 	}
 
-	for _, f := range fl.Features {
+	for _, f := range fl.Data {
 		if l := 6; l != len(f.Beer.ID) {
 			t.Fatalf("Features Beer.ID len = %d, wanted %d", len(f.Beer.ID), l)
 		}
@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -18,8 +20,8 @@ type Characteristic struct {
 	ID          int
 	Name        string
 	Description string
-	CreateDate  string
-	UpdateDate  string
+	CreateDate  Timestamp
+	UpdateDate  Timestamp
 }
 
 // Fermentable represents a Fermentable Beer ingredient.
@@ -41,8 +43,8 @@ type Fermentable struct {
 	RequiresMashing      YesNo
 	Category             string // This will always be set to "malt"
 	CategoryDisplay      string // This will always be set to "Malts, Grains, & Fermentables"
-	CreateDate           string
-	UpdateDate           string
+	CreateDate           Timestamp
+	UpdateDate           Timestamp
 	SRM                  SRM
 	Country              struct {
 		IsoCode     string
@@ -50,26 +52,26 @@ type Fermentable struct {
 		DisplayName string
 		IsoThree    string
 		NumberCode  int
-		CreateDate  string
+		CreateDate  Timestamp
 	}
 	Characteristics []Characteristic
 }
 
 // FermentableList represents a "page" containing a slice of Fermentables.
-type FermentableList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Fermentables  []Fermentable `json:"data"`
-}
+type FermentableList = Page[Fermentable]
 
 // List returns a list of Fermentable Beer ingredients.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/fermentable_index#1
 func (fs *FermentableService) List(page int) (fl FermentableList, err error) {
+	return fs.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (fs *FermentableService) ListContext(ctx context.Context, page int) (fl FermentableList, err error) {
 	// GET: /fermentables
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", "/fermentables", &Page{page})
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", "/fermentables", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -78,22 +80,52 @@ func (fs *FermentableService) List(page int) (fl FermentableList, err error) {
 	return
 }
 
+// All returns an iterator over the Fermentables on every page.
+// Pages are requested as the iteration proceeds.
+func (fs *FermentableService) All() iter.Seq2[Fermentable, error] {
+	return fs.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (fs *FermentableService) AllContext(ctx context.Context) iter.Seq2[Fermentable, error] {
+	return paginate(1, func(page int) ([]Fermentable, int, error) {
+		l, err := fs.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Fermentables on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (fs *FermentableService) FetchAll(workers int) ([]Fermentable, error) {
+	return fs.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (fs *FermentableService) FetchAllContext(ctx context.Context, workers int) ([]Fermentable, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Fermentable, int, error) {
+		l, err := fs.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get returns the Fermentable with the given Fermentable ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/fermentable_index#2
 func (fs *FermentableService) Get(id int) (f Fermentable, err error) {
+	return fs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (fs *FermentableService) GetContext(ctx context.Context, id int) (f Fermentable, err error) {
 	// GET: /fermentable/:fermentableID
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", fmt.Sprintf("/fermentable/%d", id), nil)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/fermentable/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Fermentable
-		Message string
-	}{}
+	var resp Envelope[Fermentable]
 	err = fs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -53,10 +53,10 @@ func TestFermentableList(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if len(fl.Fermentables) <= 0 {
+	if len(fl.Data) <= 0 {
 		t.Error("Expected >0 fermentables")
 	}
-	for _, f := range fl.Fermentables {
+	for _, f := range fl.Data {
 		if f.ID <= 0 {
 			t.Fatal("Expected non-zero fermentable ID")
 		}
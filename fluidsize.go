@@ -1,6 +1,7 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -30,25 +31,26 @@ type Fluidsize struct {
 	Volume        string
 	VolumeDisplay string
 	Quantity      string
-	CreateDate    string
+	CreateDate    Timestamp
 }
 
 // List returns a list of Fluidsizes.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/fluidsize_index#1
 func (fs *FluidsizeService) List() (fl []Fluidsize, err error) {
+	return fs.ListContext(context.Background())
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (fs *FluidsizeService) ListContext(ctx context.Context) (fl []Fluidsize, err error) {
 	// GET: /fluidsizes
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", "/fluidsizes", nil)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", "/fluidsizes", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Fluidsize
-		Message string
-	}{}
+	var resp Envelope[[]Fluidsize]
 	err = fs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -57,18 +59,19 @@ func (fs *FluidsizeService) List() (fl []Fluidsize, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/fluidsize_index#2
 func (fs *FluidsizeService) Get(id int) (f Fluidsize, err error) {
+	return fs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (fs *FluidsizeService) GetContext(ctx context.Context, id int) (f Fluidsize, err error) {
 	// GET: /fluidsize/:fluidsizeId
 	var req *http.Request
-	req, err = fs.c.NewRequest("GET", fmt.Sprintf("/fluidsize/%d", id), nil)
+	req, err = fs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/fluidsize/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Fluidsize
-		Message string
-	}{}
+	var resp Envelope[Fluidsize]
 	err = fs.c.Do(req, &resp)
 	return resp.Data, err
 }
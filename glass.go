@@ -1,6 +1,7 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -18,26 +19,27 @@ type Glass struct {
 	ID          int
 	Name        string
 	Description string
-	CreateDate  string
-	UpdateDate  string
+	CreateDate  Timestamp
+	UpdateDate  Timestamp
 }
 
 // List returns a list of Glasses.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/glass_index#1
 func (gs *GlassService) List() (gl []Glass, err error) {
+	return gs.ListContext(context.Background())
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (gs *GlassService) ListContext(ctx context.Context) (gl []Glass, err error) {
 	// GET: /glassware
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", "/glassware", nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", "/glassware", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Glass
-		Message string
-	}{}
+	var resp Envelope[[]Glass]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -46,18 +48,19 @@ func (gs *GlassService) List() (gl []Glass, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/glass_index#2
 func (gs *GlassService) Get(id int) (g Glass, err error) {
+	return gs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (gs *GlassService) GetContext(ctx context.Context, id int) (g Glass, err error) {
 	// GET: /glass/:glassId
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", fmt.Sprintf("/glass/%d", id), nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/glass/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Glass
-		Message string
-	}{}
+	var resp Envelope[Glass]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
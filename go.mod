@@ -0,0 +1,5 @@
+module github.com/naegelejd/brewerydb
+
+go 1.23
+
+require github.com/google/go-querystring v1.1.0
@@ -1,8 +1,11 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // GuildService provides access to the BreweryDB Guild API.
@@ -13,15 +16,15 @@ type GuildService struct {
 
 // Guild represents a Beer or Brewing organization.
 type Guild struct {
-	ID          string `url:"-"`
-	Name        string `url:"name"` // Required
-	Description string `url:"description,omitempty"`
-	Website     string `url:"website,omitempty"`
-	Image       string `url:"image,omitempty"` // Base64. Only used for adding/updating Guilds.
-	Images      Images `url:"-"`
-	Established int    `url:"established,omitempty"`
-	CreateDate  string `url:"-"`
-	UpdateDate  string `url:"-"`
+	ID          string    `url:"-"`
+	Name        string    `url:"name"` // Required
+	Description string    `url:"description,omitempty"`
+	Website     string    `url:"website,omitempty"`
+	Image       string    `url:"image,omitempty"` // Base64. Only used for adding/updating Guilds.
+	Images      Images    `url:"-"`
+	Established int       `url:"established,omitempty"`
+	CreateDate  Timestamp `url:"-"`
+	UpdateDate  Timestamp `url:"-"`
 }
 
 // GuildOrder specifies ordering of a GuildList.
@@ -43,7 +46,7 @@ type GuildListRequest struct {
 	IDs         string     `url:"ids,omitempty"`
 	Name        string     `url:"name,omitempty"`        // Required for non-premium users.
 	Established int        `url:"established,omitempty"` // Year
-	Since       int        `url:"since,omitempty"`
+	Since       time.Time  `url:"since,omitempty,unix"`  // Max 30 days ago
 	Status      string     `url:"status,omitempty"`
 	HasImages   YesNo      `url:"hasImages,omitempty"`
 	Order       GuildOrder `url:"order,omitempty"`
@@ -51,19 +54,19 @@ type GuildListRequest struct {
 }
 
 // GuildList represents a single "page" containing a slice of Guilds.
-type GuildList struct {
-	NumberOfPages int
-	CurrentPage   int
-	TotalResults  int
-	Guilds        []Guild `json:"data"`
-}
+type GuildList = Page[Guild]
 
 // List returns an GuildList containing a "page" of Guilds.
 // For non-premium members, Name must be set.
 func (gs *GuildService) List(q *GuildListRequest) (gl GuildList, err error) {
+	return gs.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (gs *GuildService) ListContext(ctx context.Context, q *GuildListRequest) (gl GuildList, err error) {
 	// GET: /guilds
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", "/guilds", q)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", "/guilds", q)
 	if err != nil {
 		return
 	}
@@ -72,43 +75,102 @@ func (gs *GuildService) List(q *GuildListRequest) (gl GuildList, err error) {
 	return
 }
 
+// All returns an iterator over the Guilds on every page matching the
+// given GuildListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (gs *GuildService) All(q *GuildListRequest) iter.Seq2[Guild, error] {
+	return gs.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (gs *GuildService) AllContext(ctx context.Context, q *GuildListRequest) iter.Seq2[Guild, error] {
+	var req GuildListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Guild, int, error) {
+		r := req
+		r.Page = page
+		l, err := gs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Guilds on every page matching the given
+// GuildListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (gs *GuildService) FetchAll(q *GuildListRequest, workers int) ([]Guild, error) {
+	return gs.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (gs *GuildService) FetchAllContext(ctx context.Context, q *GuildListRequest, workers int) ([]Guild, error) {
+	var req GuildListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Guild, int, error) {
+		r := req
+		r.Page = page
+		l, err := gs.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get retrieves a single Guild with the given guildID.
 func (gs *GuildService) Get(guildID string) (g Guild, err error) {
+	return gs.GetContext(context.Background(), guildID)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (gs *GuildService) GetContext(ctx context.Context, guildID string) (g Guild, err error) {
 	// GET: /guild/:guildID
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", "/guild/"+guildID, nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", "/guild/"+guildID, nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Guild
-		Message string
-	}{}
+	var resp Envelope[Guild]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
+// GetMany queries for the Guilds with the given IDs, which are requested
+// concurrently in batches of 10. It returns the Guilds found, in the order
+// of ids, and the IDs that were not found.
+func (gs *GuildService) GetMany(ids []string) ([]Guild, []string, error) {
+	return gs.GetManyContext(context.Background(), ids)
+}
+
+// GetManyContext is like GetMany but uses the given Context for the requests.
+func (gs *GuildService) GetManyContext(ctx context.Context, ids []string) ([]Guild, []string, error) {
+	id := func(v Guild) string { return v.ID }
+	return getMany(ctx, ids, id, func(ctx context.Context, ids string) ([]Guild, error) {
+		l, err := gs.ListContext(ctx, &GuildListRequest{Page: 1, IDs: ids})
+		return l.Data, err
+	})
+}
+
 // Add adds a Guild to the BreweryDB and returns its new ID.
 // The Guild Name is required.
 func (gs *GuildService) Add(g *Guild) (string, error) {
+	return gs.AddContext(context.Background(), g)
+}
+
+// AddContext is like Add but uses the given Context for the request.
+func (gs *GuildService) AddContext(ctx context.Context, g *Guild) (string, error) {
 	// POST: /guilds
 	if g == nil {
 		return "", fmt.Errorf("nil Guild")
 	}
-	req, err := gs.c.NewRequest("POST", "/guilds", g)
+	req, err := gs.c.NewRequestWithContext(ctx, "POST", "/guilds", g)
 	if err != nil {
 		return "", err
 	}
 
-	resp := struct {
-		Status string
-		Data   struct {
-			ID string
-		}
-		Message string
-	}{}
+	var resp Envelope[struct{ ID string }]
 
 	err = gs.c.Do(req, &resp)
 	return resp.Data.ID, err
@@ -116,11 +178,16 @@ func (gs *GuildService) Add(g *Guild) (string, error) {
 
 // Update updates the Guild with the given guildID to match the given Guild.
 func (gs *GuildService) Update(guildID string, g *Guild) error {
+	return gs.UpdateContext(context.Background(), guildID, g)
+}
+
+// UpdateContext is like Update but uses the given Context for the request.
+func (gs *GuildService) UpdateContext(ctx context.Context, guildID string, g *Guild) error {
 	// PUT: /guild/:guildID
 	if g == nil {
 		return fmt.Errorf("nil Guild")
 	}
-	req, err := gs.c.NewRequest("PUT", "/guild/"+guildID, g)
+	req, err := gs.c.NewRequestWithContext(ctx, "PUT", "/guild/"+guildID, g)
 	if err != nil {
 		return err
 	}
@@ -130,8 +197,13 @@ func (gs *GuildService) Update(guildID string, g *Guild) error {
 
 // Delete removes the Guild with the given guildID.
 func (gs *GuildService) Delete(guildID string) error {
+	return gs.DeleteContext(context.Background(), guildID)
+}
+
+// DeleteContext is like Delete but uses the given Context for the request.
+func (gs *GuildService) DeleteContext(ctx context.Context, guildID string) error {
 	// DELETE: /guild/:guildID
-	req, err := gs.c.NewRequest("DELETE", "/guild/"+guildID, nil)
+	req, err := gs.c.NewRequestWithContext(ctx, "DELETE", "/guild/"+guildID, nil)
 	if err != nil {
 		return err
 	}
@@ -141,65 +213,73 @@ func (gs *GuildService) Delete(guildID string) error {
 
 // ListBreweries returns a slice of all Breweries that are members of the given Guild.
 func (gs *GuildService) ListBreweries(guildID string) (bl []Brewery, err error) {
+	return gs.ListBreweriesContext(context.Background(), guildID)
+}
+
+// ListBreweriesContext is like ListBreweries but uses the given Context for the request.
+func (gs *GuildService) ListBreweriesContext(ctx context.Context, guildID string) (bl []Brewery, err error) {
 	// GET: /guild/:guildId/breweries
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", "/guild/"+guildID+"/breweries", nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", "/guild/"+guildID+"/breweries", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Brewery
-		Message string
-	}{}
+	var resp Envelope[[]Brewery]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // ListSocialAccounts returns a slice of all social media accounts associated with the given Guild.
 func (gs *GuildService) ListSocialAccounts(guildID string) (sl []SocialAccount, err error) {
+	return gs.ListSocialAccountsContext(context.Background(), guildID)
+}
+
+// ListSocialAccountsContext is like ListSocialAccounts but uses the given Context for the request.
+func (gs *GuildService) ListSocialAccountsContext(ctx context.Context, guildID string) (sl []SocialAccount, err error) {
 	// GET: /guild/:guildId/socialaccounts
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", "/guild/"+guildID+"/socialaccounts", nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", "/guild/"+guildID+"/socialaccounts", nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    []SocialAccount
-		Message string
-	}{}
+	var resp Envelope[[]SocialAccount]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // GetSocialAccount retrieves the SocialAccount with the given ID for the given Guild.
 func (gs *GuildService) GetSocialAccount(guildID string, socialAccountID int) (s SocialAccount, err error) {
+	return gs.GetSocialAccountContext(context.Background(), guildID, socialAccountID)
+}
+
+// GetSocialAccountContext is like GetSocialAccount but uses the given Context for the request.
+func (gs *GuildService) GetSocialAccountContext(ctx context.Context, guildID string, socialAccountID int) (s SocialAccount, err error) {
 	// GET: /guild/:guildId/socialaccount/:socialAccountId
 	var req *http.Request
-	req, err = gs.c.NewRequest("GET", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, socialAccountID), nil)
+	req, err = gs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, socialAccountID), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    SocialAccount
-		Message string
-	}{}
+	var resp Envelope[SocialAccount]
 	err = gs.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // AddSocialAccount adds a new SocialAccount to the given Guild.
 func (gs *GuildService) AddSocialAccount(guildID string, s *SocialAccount) error {
+	return gs.AddSocialAccountContext(context.Background(), guildID, s)
+}
+
+// AddSocialAccountContext is like AddSocialAccount but uses the given Context for the request.
+func (gs *GuildService) AddSocialAccountContext(ctx context.Context, guildID string, s *SocialAccount) error {
 	// POST: /guild/:guildId/socialaccounts
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := gs.c.NewRequest("POST", "/guild/"+guildID+"/socialaccounts", s)
+	req, err := gs.c.NewRequestWithContext(ctx, "POST", "/guild/"+guildID+"/socialaccounts", s)
 	if err != nil {
 		return err
 	}
@@ -209,11 +289,16 @@ func (gs *GuildService) AddSocialAccount(guildID string, s *SocialAccount) error
 
 // UpdateSocialAccount updates a SocialAccount for the given Guild.
 func (gs *GuildService) UpdateSocialAccount(guildID string, s *SocialAccount) error {
+	return gs.UpdateSocialAccountContext(context.Background(), guildID, s)
+}
+
+// UpdateSocialAccountContext is like UpdateSocialAccount but uses the given Context for the request.
+func (gs *GuildService) UpdateSocialAccountContext(ctx context.Context, guildID string, s *SocialAccount) error {
 	// PUT: /guild/:guildId/socialaccount/:socialAccountId
 	if s == nil {
 		return fmt.Errorf("nil SocialAccount")
 	}
-	req, err := gs.c.NewRequest("PUT", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, s.ID), s)
+	req, err := gs.c.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, s.ID), s)
 	if err != nil {
 		return err
 	}
@@ -223,8 +308,13 @@ func (gs *GuildService) UpdateSocialAccount(guildID string, s *SocialAccount) er
 
 // DeleteSocialAccount removes a SocialAccount from the given Guild.
 func (gs *GuildService) DeleteSocialAccount(guildID string, socialAccountID int) error {
+	return gs.DeleteSocialAccountContext(context.Background(), guildID, socialAccountID)
+}
+
+// DeleteSocialAccountContext is like DeleteSocialAccount but uses the given Context for the request.
+func (gs *GuildService) DeleteSocialAccountContext(ctx context.Context, guildID string, socialAccountID int) error {
 	// DELETE: /guild/:guildId/socialaccount/:socialAccountId
-	req, err := gs.c.NewRequest("DELETE", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, socialAccountID), nil)
+	req, err := gs.c.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/guild/%s/socialaccount/%d", guildID, socialAccountID), nil)
 	if err != nil {
 		return err
 	}
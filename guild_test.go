@@ -62,11 +62,11 @@ func TestGuildList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(gl.Guilds) <= 0 {
+	if len(gl.Data) <= 0 {
 		t.Fatal("Expected >0 guilds")
 	}
 
-	for _, g := range gl.Guilds {
+	for _, g := range gl.Data {
 		if l := 6; l != len(g.ID) {
 			t.Fatalf("Guild ID len = %d, wanted %d", len(g.ID), l)
 		}
@@ -1,5 +1,7 @@
 package brewerydb
 
+import "context"
+
 // HeartbeatService provides access to the BreweryDB Heartbeat API.
 // Use Client.Heartbeat.
 //
@@ -26,7 +28,12 @@ type HeartbeatResponse struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/heartbeat_index#1
 func (hs *HeartbeatService) Heartbeat() error {
-	req, err := hs.c.NewRequest("GET", "/heartbeat", nil)
+	return hs.HeartbeatContext(context.Background())
+}
+
+// HeartbeatContext is like Heartbeat but uses the given Context for the request.
+func (hs *HeartbeatService) HeartbeatContext(ctx context.Context) error {
+	req, err := hs.c.NewRequestWithContext(ctx, "GET", "/heartbeat", nil)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -13,12 +15,7 @@ type HopService struct {
 }
 
 // HopList represents a "page" containing a slice of Hops.
-type HopList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Hops          []Hop `json:"data"`
-}
+type HopList = Page[Hop]
 
 // Hop contains all relevant information for a single variety of Hop.
 type Hop struct {
@@ -46,15 +43,15 @@ type Hop struct {
 	ForAroma         YesNo
 	Category         string
 	CategoryDisplay  string
-	CreateDate       string
-	UpdateDate       string
+	CreateDate       Timestamp
+	UpdateDate       Timestamp
 	Country          struct {
 		IsoCode     string
 		Name        string
 		DisplayName string
 		IsoThree    string
 		NumberCode  int
-		CreateDate  string
+		CreateDate  Timestamp
 	}
 }
 
@@ -62,8 +59,13 @@ type Hop struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/hop_index#1
 func (hs *HopService) List(page int) (hl HopList, err error) {
+	return hs.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (hs *HopService) ListContext(ctx context.Context, page int) (hl HopList, err error) {
 	var req *http.Request
-	req, err = hs.c.NewRequest("GET", "/hops", &Page{page})
+	req, err = hs.c.NewRequestWithContext(ctx, "GET", "/hops", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -72,22 +74,52 @@ func (hs *HopService) List(page int) (hl HopList, err error) {
 	return
 }
 
+// All returns an iterator over the Hops on every page.
+// Pages are requested as the iteration proceeds.
+func (hs *HopService) All() iter.Seq2[Hop, error] {
+	return hs.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (hs *HopService) AllContext(ctx context.Context) iter.Seq2[Hop, error] {
+	return paginate(1, func(page int) ([]Hop, int, error) {
+		l, err := hs.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Hops on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (hs *HopService) FetchAll(workers int) ([]Hop, error) {
+	return hs.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (hs *HopService) FetchAllContext(ctx context.Context, workers int) ([]Hop, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Hop, int, error) {
+		l, err := hs.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get queries for a single Hop with the given Hop ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/hop_index#2
 func (hs *HopService) Get(id int) (hop Hop, err error) {
+	return hs.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (hs *HopService) GetContext(ctx context.Context, id int) (hop Hop, err error) {
 	// GET: /hop/:hopId
 	var req *http.Request
-	req, err = hs.c.NewRequest("GET", fmt.Sprintf("/hop/%d", id), nil)
+	req, err = hs.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/hop/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	hopResp := struct {
-		Message string
-		Data    Hop
-		Status  string
-	}{}
+	var hopResp Envelope[Hop]
 
 	err = hs.c.Do(req, &hopResp)
 	return hopResp.Data, err
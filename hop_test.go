@@ -55,12 +55,12 @@ func TestHopList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(hl.Hops) <= 0 {
+	if len(hl.Data) <= 0 {
 		t.Fatal("Expected >0 hops")
 	}
 
 	c := "hop"
-	for _, h := range hl.Hops {
+	for _, h := range hl.Data {
 		if c != h.Category {
 			t.Fatalf("Hop Category = %s, wanted %s", h.Category, c)
 		}
@@ -91,7 +91,7 @@ func ExampleHopService_List() {
 	if err != nil {
 		panic(err)
 	}
-	for _, h := range hl.Hops {
+	for _, h := range hl.Data {
 		fmt.Println(h.Name)
 	}
 }
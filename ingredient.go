@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -19,25 +21,25 @@ type Ingredient struct {
 	Name            string
 	Category        string
 	CategoryDisplay string
-	CreateDate      string
-	UpdateDate      string
+	CreateDate      Timestamp
+	UpdateDate      Timestamp
 }
 
 // IngredientList represents a single "page" containing a slice of Ingredients.
-type IngredientList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Ingredients   []Ingredient `json:"data"`
-}
+type IngredientList = Page[Ingredient]
 
 // List returns all Ingredients on the given page.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/ingredient_index#1
 func (is *IngredientService) List(page int) (il IngredientList, err error) {
+	return is.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (is *IngredientService) ListContext(ctx context.Context, page int) (il IngredientList, err error) {
 	// GET: /ingredients
 	var req *http.Request
-	req, err = is.c.NewRequest("GET", "/ingredients", &Page{page})
+	req, err = is.c.NewRequestWithContext(ctx, "GET", "/ingredients", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -46,22 +48,52 @@ func (is *IngredientService) List(page int) (il IngredientList, err error) {
 	return
 }
 
+// All returns an iterator over the Ingredients on every page.
+// Pages are requested as the iteration proceeds.
+func (is *IngredientService) All() iter.Seq2[Ingredient, error] {
+	return is.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (is *IngredientService) AllContext(ctx context.Context) iter.Seq2[Ingredient, error] {
+	return paginate(1, func(page int) ([]Ingredient, int, error) {
+		l, err := is.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Ingredients on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (is *IngredientService) FetchAll(workers int) ([]Ingredient, error) {
+	return is.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (is *IngredientService) FetchAllContext(ctx context.Context, workers int) ([]Ingredient, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Ingredient, int, error) {
+		l, err := is.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get returns the Ingredient with the given Ingredient ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/ingredient_index#2
 func (is *IngredientService) Get(id int) (ing Ingredient, err error) {
+	return is.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (is *IngredientService) GetContext(ctx context.Context, id int) (ing Ingredient, err error) {
 	// GET: /ingredient/:ingredientId
 	var req *http.Request
-	req, err = is.c.NewRequest("GET", fmt.Sprintf("/ingredient/%d", id), nil)
+	req, err = is.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/ingredient/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Ingredient
-		Message string
-	}{}
+	var resp Envelope[Ingredient]
 	err = is.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -53,11 +53,11 @@ func TestIngredientList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(il.Ingredients) <= 0 {
+	if len(il.Data) <= 0 {
 		t.Fatal("Expected >0 ingredients")
 	}
 
-	for _, i := range il.Ingredients {
+	for _, i := range il.Data {
 		if i.ID <= 0 {
 			t.Fatal("Expected non-zero ingredient ID")
 		}
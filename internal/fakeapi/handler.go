@@ -0,0 +1,169 @@
+// Copyright 2015 Joseph Naegele. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fakeapi implements the BreweryDB API over an in-memory store of
+// entities decoded from JSON. It backs both the brewerydbtest fake server
+// and the offline Client backend, which each fill the store with their own
+// data.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DefaultPageSize is the number of results per page of a list or search,
+// as used by BreweryDB.
+const DefaultPageSize = 50
+
+// Handler serves the BreweryDB API from an in-memory store. It is safe for
+// concurrent use. Its exported fields should be set before it is first used.
+type Handler struct {
+	Premium  bool // Allow unfiltered lists and premium-only parameters
+	ReadOnly bool // Reject requests that add, update or delete entities
+	PageSize int  // Number of results per page
+
+	mu    sync.Mutex
+	store *store
+	menus map[string][]byte
+}
+
+// New returns a Handler with an empty store and no menus.
+func New() *Handler {
+	return &Handler{PageSize: DefaultPageSize, store: newStore(), menus: make(map[string][]byte)}
+}
+
+// Insert adds or replaces an entity of the given type, e.g. "beer" or
+// "style", decoded from JSON into e.
+func (h *Handler) Insert(typ string, e map[string]interface{}) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	t, ok := h.store.types[typ]
+	if !ok {
+		return fmt.Errorf("fakeapi: unknown entity type %q", typ)
+	}
+	t.insert(e)
+	return nil
+}
+
+// SetMenu sets the data served by the given menu endpoint, e.g. "styles".
+func (h *Handler) SetMenu(name string, data interface{}) error {
+	b, err := json.Marshal(success(data))
+	if err != nil {
+		return err
+	}
+	h.SetMenuResponse(name, b)
+	return nil
+}
+
+// SetMenuResponse sets the complete JSON response, including its status,
+// served by the given menu endpoint.
+func (h *Handler) SetMenuResponse(name string, resp []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.menus[name] = resp
+}
+
+// apiError is an error response sent by the Handler.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string { return e.message }
+
+func errorf(status int, format string, args ...interface{}) *apiError {
+	return &apiError{status, fmt.Sprintf(format, args...)}
+}
+
+var (
+	errPremium  = errorf(http.StatusUnauthorized, "This request requires a premium membership")
+	errNotFound = errorf(http.StatusNotFound, "The endpoint you requested could not be found")
+	errReadOnly = errorf(http.StatusMethodNotAllowed, "This API is read-only")
+)
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if r.Method == "POST" || r.Method == "PUT" {
+		if err := r.ParseForm(); err != nil {
+			WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	resp, err := h.route(r)
+	if err != nil {
+		e := err.(*apiError)
+		WriteError(w, e.status, e.message)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// WriteError writes a BreweryDB error response.
+func WriteError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"status":       "failure",
+		"errorMessage": message,
+	})
+}
+
+// route handles a request for the given endpoint and returns the
+// response to encode.
+func (h *Handler) route(r *http.Request) (interface{}, error) {
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if h.ReadOnly && r.Method != "GET" {
+		return nil, errReadOnly
+	}
+	switch {
+	case segs[0] == "menu" && len(segs) == 2 && r.Method == "GET":
+		data, ok := h.menus[segs[1]]
+		if !ok {
+			return nil, errNotFound
+		}
+		return json.RawMessage(data), nil
+	case segs[0] == "search" && len(segs) == 1 && r.Method == "GET":
+		return h.search(r, r.URL.Query().Get("type"))
+	case segs[0] == "search" && len(segs) == 2 && segs[1] == "style" && r.Method == "GET":
+		return h.search(r, "style")
+	case segs[0] == "brewery" && len(segs) == 3 && segs[2] == "locations":
+		return h.breweryLocations(r, segs[1])
+	}
+
+	if typ, ok := collections[segs[0]]; ok && len(segs) == 1 {
+		switch r.Method {
+		case "GET":
+			return h.list(r, typ)
+		case "POST":
+			return h.add(r, typ, nil)
+		}
+	}
+	if typ, ok := references[segs[0]]; ok && len(segs) == 1 && r.Method == "GET" {
+		return h.page(r.URL.Query(), h.query(typ, r.URL.Query()))
+	}
+	if _, ok := h.store.types[segs[0]]; ok && len(segs) == 2 {
+		typ := segs[0]
+		_, writable := formKinds[typ]
+		switch {
+		case r.Method == "GET" && segs[1] == "random" && writable:
+			return h.random(r, typ)
+		case r.Method == "GET":
+			return h.get(typ, segs[1])
+		case r.Method == "PUT" && writable:
+			return h.update(r, typ, segs[1])
+		case r.Method == "DELETE" && writable:
+			return h.delete(typ, segs[1])
+		}
+	}
+	return nil, errNotFound
+}
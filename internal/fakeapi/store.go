@@ -0,0 +1,446 @@
+package fakeapi
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naegelejd/brewerydb"
+)
+
+// dateLayout is the format of BreweryDB's createDate and updateDate fields.
+const dateLayout = "2006-01-02 15:04:05"
+
+// entity is a single Beer, Brewery, etc. as decoded from JSON.
+type entity map[string]interface{}
+
+// table holds all entities of a single type in insertion order.
+type table struct {
+	ids   []string
+	items map[string]entity
+}
+
+func (t *table) insert(e entity) {
+	id := format(field(e, "id"))
+	if _, ok := t.items[id]; !ok {
+		t.ids = append(t.ids, id)
+	}
+	t.items[id] = e
+}
+
+func (t *table) remove(id string) {
+	delete(t.items, id)
+	for i, v := range t.ids {
+		if v == id {
+			t.ids = append(t.ids[:i], t.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// store holds the entities served by a Handler, keyed by entity type,
+// e.g. "beer".
+type store struct {
+	types  map[string]*table
+	nextID int
+}
+
+// collections maps the endpoints that list entities to their entity type.
+var collections = map[string]string{
+	"beers":     "beer",
+	"breweries": "brewery",
+	"locations": "location",
+	"events":    "event",
+	"guilds":    "guild",
+}
+
+// references maps the endpoints that list read-only reference data to
+// their entity type.
+var references = map[string]string{
+	"styles":       "style",
+	"categories":   "category",
+	"glassware":    "glass",
+	"fluidsizes":   "fluidsize",
+	"socialsites":  "socialsite",
+	"ingredients":  "ingredient",
+	"hops":         "hop",
+	"yeasts":       "yeast",
+	"fermentables": "fermentable",
+	"adjuncts":     "adjunct",
+}
+
+// formKinds maps each entity type to the kinds of the fields of the
+// corresponding brewerydb type, keyed by their form (url tag) names.
+var formKinds = map[string]map[string]reflect.Kind{
+	"beer":     fieldKinds(brewerydb.Beer{}),
+	"brewery":  fieldKinds(brewerydb.Brewery{}),
+	"location": fieldKinds(brewerydb.Location{}),
+	"event":    fieldKinds(brewerydb.Event{}),
+	"guild":    fieldKinds(brewerydb.Guild{}),
+}
+
+// filterRequired are the entity types that non-premium members may only
+// list when filtering by at least one parameter.
+var filterRequired = map[string]bool{
+	"beer":    true,
+	"brewery": true,
+	"guild":   true,
+}
+
+// premiumParams are parameters reserved for premium members.
+var premiumParams = []string{"withSocialAccounts", "withIngredients"}
+
+func fieldKinds(v interface{}) map[string]reflect.Kind {
+	t := reflect.TypeOf(v)
+	kinds := make(map[string]reflect.Kind)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("url"), ",")
+		if name != "" && name != "-" {
+			kinds[name] = f.Type.Kind()
+		}
+	}
+	return kinds
+}
+
+func newStore() *store {
+	s := &store{types: make(map[string]*table)}
+	for _, typ := range collections {
+		s.types[typ] = &table{items: make(map[string]entity)}
+	}
+	for _, typ := range references {
+		s.types[typ] = &table{items: make(map[string]entity)}
+	}
+	return s
+}
+
+// field returns the value of the given field of e. Field names are matched
+// case-insensitively if there is no exact match, so that entities encoded
+// from brewerydb types, e.g. with an "ID" field, are served like those
+// decoded from the API.
+func field(e entity, name string) interface{} {
+	if v, ok := e[name]; ok {
+		return v
+	}
+	for k, v := range e {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// format returns the string form of a JSON value, as it would appear in
+// a query parameter.
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// filters returns the query parameters of r used to filter entities.
+func filters(q url.Values) url.Values {
+	f := url.Values{}
+	for k, v := range q {
+		switch {
+		case k == "key" || k == "p" || k == "order" || k == "sort" || k == "randomCount":
+		case strings.HasPrefix(k, "with"):
+		case v[0] == "":
+		default:
+			f[k] = v
+		}
+	}
+	return f
+}
+
+// match reports whether e matches all of the given filters.
+func match(e entity, f url.Values) bool {
+	for k := range f {
+		v := f.Get(k)
+		switch k {
+		case "ids":
+			if !contains(strings.Split(v, ","), format(field(e, "id"))) {
+				return false
+			}
+		case "since":
+			since, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return false
+			}
+			updated, err := time.Parse(dateLayout, format(field(e, "updateDate")))
+			if err != nil {
+				updated, _ = time.Parse(dateLayout, format(field(e, "createDate")))
+			}
+			if updated.Unix() < since {
+				return false
+			}
+		case "abv", "ibu", "year":
+			r, err := brewerydb.ParseRange(v)
+			if err != nil {
+				return false
+			}
+			n, err := strconv.ParseFloat(format(field(e, k)), 64)
+			if err != nil || !r.Contains(brewerydb.NewNumber(n)) {
+				return false
+			}
+		default:
+			if !strings.EqualFold(format(field(e, k)), v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// less orders JSON values, numerically if both are numbers.
+func less(a, b interface{}) bool {
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			return x < y
+		}
+	}
+	return format(a) < format(b)
+}
+
+// checkPremium returns errPremium if a non-premium member requested any
+// premium-only parameters.
+func (h *Handler) checkPremium(q url.Values) error {
+	if h.Premium {
+		return nil
+	}
+	for _, p := range premiumParams {
+		if q.Get(p) == "Y" {
+			return errPremium
+		}
+	}
+	return nil
+}
+
+// page returns a single page of the given entities.
+func (h *Handler) page(q url.Values, es []entity) (interface{}, error) {
+	p := 1
+	if v := q.Get("p"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errorf(http.StatusBadRequest, "Invalid page number: %s", v)
+		}
+		p = max(n, 1)
+	}
+	pages := (len(es) + h.PageSize - 1) / h.PageSize
+	start := min((p-1)*h.PageSize, len(es))
+	end := min(start+h.PageSize, len(es))
+	return map[string]interface{}{
+		"currentPage":   p,
+		"numberOfPages": pages,
+		"totalResults":  len(es),
+		"data":          es[start:end],
+		"status":        "success",
+	}, nil
+}
+
+// query returns the entities of the given type matching the filters in q,
+// in the requested order.
+func (h *Handler) query(typ string, q url.Values) []entity {
+	t := h.store.types[typ]
+	f := filters(q)
+	es := []entity{}
+	for _, id := range t.ids {
+		if e := t.items[id]; match(e, f) {
+			es = append(es, e)
+		}
+	}
+	if order := q.Get("order"); order != "" && order != "random" {
+		desc := strings.EqualFold(q.Get("sort"), "DESC")
+		sort.SliceStable(es, func(i, j int) bool {
+			if desc {
+				return less(field(es[j], order), field(es[i], order))
+			}
+			return less(field(es[i], order), field(es[j], order))
+		})
+	}
+	return es
+}
+
+func (h *Handler) list(r *http.Request, typ string) (interface{}, error) {
+	q := r.URL.Query()
+	if err := h.checkPremium(q); err != nil {
+		return nil, err
+	}
+	if !h.Premium && filterRequired[typ] && len(filters(q)) == 0 {
+		return nil, errPremium
+	}
+	return h.page(q, h.query(typ, q))
+}
+
+// search returns the entities of the given type whose names contain the
+// query.
+func (h *Handler) search(r *http.Request, typ string) (interface{}, error) {
+	q := r.URL.Query()
+	if err := h.checkPremium(q); err != nil {
+		return nil, err
+	}
+	term := strings.ToLower(q.Get("q"))
+	if term == "" {
+		return nil, errorf(http.StatusBadRequest, "Missing required parameter: q")
+	}
+	t, ok := h.store.types[typ]
+	if !ok {
+		return nil, errorf(http.StatusBadRequest, "Invalid search type: %s", typ)
+	}
+	f := url.Values{}
+	for _, k := range []string{"abv", "ibu", "year"} {
+		if v := q.Get(k); v != "" {
+			f.Set(k, v)
+		}
+	}
+	es := []entity{}
+	for _, id := range t.ids {
+		e := t.items[id]
+		if strings.Contains(strings.ToLower(format(field(e, "name"))), term) && match(e, f) {
+			es = append(es, e)
+		}
+	}
+	return h.page(q, es)
+}
+
+func (h *Handler) random(r *http.Request, typ string) (interface{}, error) {
+	q := r.URL.Query()
+	if err := h.checkPremium(q); err != nil {
+		return nil, err
+	}
+	es := h.query(typ, q)
+	if len(es) == 0 {
+		return nil, errorf(http.StatusNotFound, "No results found")
+	}
+	return success(es[rand.IntN(len(es))]), nil
+}
+
+func (h *Handler) get(typ, id string) (interface{}, error) {
+	e, ok := h.store.types[typ].items[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Invalid %s id: %s", typ, id)
+	}
+	return success(e), nil
+}
+
+// add adds a new entity of the given type from the request's form.
+// Locations are added to the given Brewery.
+func (h *Handler) add(r *http.Request, typ string, brewery entity) (interface{}, error) {
+	if typ == "location" && brewery == nil {
+		return nil, errNotFound
+	}
+	if r.PostForm.Get("name") == "" && typ != "location" {
+		return nil, errorf(http.StatusBadRequest, "Missing required field: name")
+	}
+	if r.PostForm.Get("styleId") == "" && typ == "beer" {
+		return nil, errorf(http.StatusBadRequest, "Missing required field: styleId")
+	}
+
+	h.store.nextID++
+	id := fmt.Sprintf("new%03d", h.store.nextID)
+	now := time.Now().UTC().Format(dateLayout)
+	e := entity{
+		"id":            id,
+		"status":        "verified",
+		"statusDisplay": "Verified",
+		"createDate":    now,
+		"updateDate":    now,
+	}
+	if err := applyForm(e, typ, r.PostForm); err != nil {
+		return nil, err
+	}
+	idKey := "id"
+	if brewery != nil {
+		e["breweryId"] = brewery["id"]
+		e["brewery"] = brewery
+		idKey = "guid"
+	}
+	h.store.types[typ].insert(e)
+	return map[string]interface{}{
+		"status": "success",
+		"data":   map[string]string{idKey: id},
+	}, nil
+}
+
+func (h *Handler) update(r *http.Request, typ, id string) (interface{}, error) {
+	e, ok := h.store.types[typ].items[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Invalid %s id: %s", typ, id)
+	}
+	if err := applyForm(e, typ, r.PostForm); err != nil {
+		return nil, err
+	}
+	e["updateDate"] = time.Now().UTC().Format(dateLayout)
+	return success(e), nil
+}
+
+func (h *Handler) delete(typ, id string) (interface{}, error) {
+	t := h.store.types[typ]
+	if _, ok := t.items[id]; !ok {
+		return nil, errorf(http.StatusNotFound, "Invalid %s id: %s", typ, id)
+	}
+	t.remove(id)
+	return map[string]string{"status": "success", "message": "Request Successful"}, nil
+}
+
+func (h *Handler) breweryLocations(r *http.Request, breweryID string) (interface{}, error) {
+	brewery, ok := h.store.types["brewery"].items[breweryID]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Invalid brewery id: %s", breweryID)
+	}
+	switch r.Method {
+	case "GET":
+		return success(h.query("location", url.Values{"breweryId": {breweryID}})), nil
+	case "POST":
+		return h.add(r, "location", brewery)
+	}
+	return nil, errNotFound
+}
+
+// applyForm sets the fields of e from the given form values, decoding
+// numbers according to the fields of the corresponding brewerydb type.
+func applyForm(e entity, typ string, form url.Values) error {
+	kinds := formKinds[typ]
+	for k, vs := range form {
+		switch kind := kinds[k]; {
+		case kind == reflect.Slice:
+			e[k] = append([]string(nil), vs...)
+		case kind >= reflect.Int && kind <= reflect.Float64:
+			n, err := strconv.ParseFloat(vs[0], 64)
+			if err != nil {
+				return errorf(http.StatusBadRequest, "Invalid value for %s: %s", k, vs[0])
+			}
+			e[k] = n
+		default:
+			e[k] = vs[0]
+		}
+	}
+	return nil
+}
+
+func success(data interface{}) interface{} {
+	return map[string]interface{}{
+		"message": "Request Successful",
+		"data":    data,
+		"status":  "success",
+	}
+}
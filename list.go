@@ -1,5 +1,12 @@
 package brewerydb
 
+import (
+	"context"
+	"iter"
+	"strings"
+	"sync"
+)
+
 // ListSort represents the sorting scheme for a list of values.
 type ListSort string
 
@@ -8,3 +15,142 @@ const (
 	SortAscending  ListSort = "ASC"
 	SortDescending          = "DESC"
 )
+
+// paginate returns an iterator over the items on every page from the given
+// page onwards. Each page is fetched only once the items on the previous
+// page have been consumed. If fetching a page fails, the error is yielded
+// and iteration stops.
+func paginate[T any](first int, fetch func(page int) (items []T, numberOfPages int, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := max(first, 1); ; page++ {
+			items, pages, err := fetch(page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if page >= pages || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// fetchPages fetches the given page, which reports the number of pages,
+// then fetches every following page using at most the given number of
+// concurrent workers. The items are returned in page order. Fetching stops
+// at the first error or when ctx is done.
+func fetchPages[T any](ctx context.Context, first, workers int, fetch func(ctx context.Context, page int) (items []T, numberOfPages int, err error)) ([]T, error) {
+	first = max(first, 1)
+	items, pages, err := fetch(ctx, first)
+	if err != nil || pages <= first {
+		return items, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]T, pages-first+1)
+	results[0] = items
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	next := make(chan int)
+	for range min(max(workers, 1), pages-first) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range next {
+				items, _, err := fetch(ctx, page)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[page-first] = items
+			}
+		}()
+	}
+
+send:
+	for page := first + 1; page <= pages; page++ {
+		select {
+		case next <- page:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	var all []T
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// Limits of requests for multiple entities by ID.
+const (
+	maxIDsPerRequest = 10 // IDs accepted by a single list request
+	getManyWorkers   = 4  // Concurrent list requests made by GetMany
+)
+
+// getMany lists the entities with the given IDs in chunks of at most
+// maxIDsPerRequest, concurrently. It returns the entities found, in the
+// order of ids, and the IDs that were not found.
+func getMany[T any](ctx context.Context, ids []string, id func(T) string, list func(ctx context.Context, ids string) ([]T, error)) ([]T, []string, error) {
+	var unique []string
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil, nil
+	}
+
+	var chunks [][]string
+	for i := 0; i < len(unique); i += maxIDsPerRequest {
+		chunks = append(chunks, unique[i:min(i+maxIDsPerRequest, len(unique))])
+	}
+	items, err := fetchPages(ctx, 1, getManyWorkers, func(ctx context.Context, page int) ([]T, int, error) {
+		items, err := list(ctx, strings.Join(chunks[page-1], ","))
+		return items, len(chunks), err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]T, len(items))
+	for _, item := range items {
+		byID[id(item)] = item
+	}
+	var found []T
+	var missing []string
+	for _, id := range unique {
+		if item, ok := byID[id]; ok {
+			found = append(found, item)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return found, missing, nil
+}
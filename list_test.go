@@ -0,0 +1,304 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPaginate(t *testing.T) {
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+	var fetched []int
+	fetch := func(page int) ([]int, int, error) {
+		fetched = append(fetched, page)
+		return pages[page-1], len(pages), nil
+	}
+
+	var got []int
+	for n, err := range paginate(0, fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, n)
+	}
+	if fmt.Sprint(got) != "[1 2 3 4 5]" || fmt.Sprint(fetched) != "[1 2 3]" {
+		t.Fatalf("got %v from pages %v", got, fetched)
+	}
+
+	// breaking early fetches no further pages
+	fetched = nil
+	for n := range paginate(2, fetch) {
+		if n == 3 {
+			break
+		}
+	}
+	if fmt.Sprint(fetched) != "[2]" {
+		t.Fatalf("fetched pages %v, want [2]", fetched)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	fetch := func(page int) ([]int, int, error) {
+		if page == 2 {
+			return nil, 0, errFetch
+		}
+		return []int{page}, 3, nil
+	}
+
+	var got []int
+	var gotErr error
+	for n, err := range paginate(1, fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, n)
+	}
+	if fmt.Sprint(got) != "[1]" || gotErr != errFetch {
+		t.Fatalf("got %v, %v", got, gotErr)
+	}
+}
+
+func TestBeerAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		checkMethod(t, r, "GET")
+		if name := r.URL.Query().Get("name"); name != "ale" {
+			t.Errorf("name = %q, want %q", name, "ale")
+		}
+		p, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		fmt.Fprintf(w, `{"currentPage":%d,"numberOfPages":3,"data":[{"id":"beer%d"}]}`, p, p)
+	})
+
+	q := &BeerListRequest{Name: "ale"}
+	var ids []string
+	for b, err := range client.Beer.All(q) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, b.ID)
+	}
+	if fmt.Sprint(ids) != "[beer1 beer2 beer3]" {
+		t.Fatalf("Beer IDs = %v", ids)
+	}
+	if q.Page != 0 {
+		t.Fatalf("BeerListRequest.Page modified to %d", q.Page)
+	}
+}
+
+func TestHopAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/hops", func(w http.ResponseWriter, r *http.Request) {
+		p, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		if p == 2 {
+			http.Error(w, `{"status":"failure","errorMessage":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"currentPage":%d,"numberOfPages":2,"data":[{"id":%d}]}`, p, p)
+	})
+
+	var ids []int
+	var gotErr error
+	for h, err := range client.Hop.All() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		ids = append(ids, h.ID)
+	}
+	if fmt.Sprint(ids) != "[1]" {
+		t.Fatalf("Hop IDs = %v", ids)
+	}
+	var apiErr *APIError
+	if !errors.As(gotErr, &apiErr) || apiErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected APIError, got %v", gotErr)
+	}
+}
+
+func TestFetchPages(t *testing.T) {
+	const pages, workers = 20, 3
+	var active, maxActive atomic.Int32
+	fetch := func(ctx context.Context, page int) ([]int, int, error) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for m := maxActive.Load(); n > m && !maxActive.CompareAndSwap(m, n); m = maxActive.Load() {
+		}
+		time.Sleep(rand.N(2 * time.Millisecond))
+		return []int{page * 10, page*10 + 1}, pages, nil
+	}
+
+	got, err := fetchPages(context.Background(), 0, workers, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2*pages {
+		t.Fatalf("got %d items, want %d", len(got), 2*pages)
+	}
+	for i, n := range got {
+		if want := (i/2+1)*10 + i%2; n != want {
+			t.Fatalf("item %d = %d, want %d", i, n, want)
+		}
+	}
+	if m := maxActive.Load(); m > workers {
+		t.Fatalf("%d concurrent fetches, want at most %d", m, workers)
+	}
+}
+
+func TestFetchPagesError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	var mu sync.Mutex
+	fetched := map[int]bool{}
+	fetch := func(ctx context.Context, page int) ([]int, int, error) {
+		mu.Lock()
+		fetched[page] = true
+		mu.Unlock()
+		if page == 3 {
+			return nil, 0, errFetch
+		}
+		if err := sleep(ctx, 5*time.Millisecond); err != nil {
+			return nil, 0, err
+		}
+		return []int{page}, 100, nil
+	}
+
+	got, err := fetchPages(context.Background(), 1, 2, fetch)
+	if err != errFetch || got != nil {
+		t.Fatalf("got %v, %v; want %v", got, err, errFetch)
+	}
+	if len(fetched) > 10 {
+		t.Fatalf("fetched %d pages after error", len(fetched))
+	}
+}
+
+func TestFetchPagesCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, page int) ([]int, int, error) {
+		if page == 1 {
+			cancel()
+			return []int{1}, 5, nil
+		}
+		return nil, 0, ctx.Err()
+	}
+	if _, err := fetchPages(ctx, 1, 2, fetch); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestBeerFetchAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		p, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		fmt.Fprintf(w, `{"currentPage":%d,"numberOfPages":5,"data":[{"id":"beer%d"}]}`, p, p)
+	})
+
+	beers, err := client.Beer.FetchAll(&BeerListRequest{StyleID: 15}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, b := range beers {
+		ids = append(ids, b.ID)
+	}
+	if fmt.Sprint(ids) != "[beer1 beer2 beer3 beer4 beer5]" {
+		t.Fatalf("Beer IDs = %v", ids)
+	}
+}
+
+func TestHopFetchAllQuota(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var served atomic.Int32
+	mux.HandleFunc("/hops", func(w http.ResponseWriter, r *http.Request) {
+		n := served.Add(1)
+		w.Header().Set(headerRateLimit, "3")
+		w.Header().Set(headerRateRemaining, strconv.Itoa(3-int(n)))
+		fmt.Fprintf(w, `{"currentPage":1,"numberOfPages":5,"data":[{"id":%d}]}`, n)
+	})
+	client.Limiter = &QuotaLimiter{}
+
+	if _, err := client.Hop.FetchAll(2); !errors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("expected ErrQuotaExhausted, got %v", err)
+	}
+	if n := served.Load(); n >= 5 {
+		t.Fatalf("served all %d requests despite exhausted quota", n)
+	}
+}
+
+func TestBeerGetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int32
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(ids) > 10 {
+			t.Errorf("requested %d IDs, want at most 10", len(ids))
+		}
+		var data []string
+		for _, id := range ids {
+			if !strings.HasPrefix(id, "missing") {
+				data = append(data, fmt.Sprintf(`{"id":%q}`, id))
+			}
+		}
+		fmt.Fprintf(w, `{"currentPage":1,"numberOfPages":1,"data":[%s]}`, strings.Join(data, ","))
+	})
+
+	var ids, want []string
+	for i := 0; i < 25; i++ {
+		ids = append(ids, fmt.Sprintf("beer%02d", i))
+	}
+	want = append(want, ids...)
+	ids = append(ids, "missing1", "beer03", "missing2")
+
+	beers, missing, err := client.Beer.GetMany(ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, b := range beers {
+		got = append(got, b.ID)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Beer IDs = %v, want %v", got, want)
+	}
+	if fmt.Sprint(missing) != "[missing1 missing2]" {
+		t.Fatalf("missing IDs = %v", missing)
+	}
+	if n := requests.Load(); n != 3 {
+		t.Fatalf("made %d requests, want 3", n)
+	}
+
+	if beers, missing, err := client.Beer.GetMany(nil); beers != nil || missing != nil || err != nil {
+		t.Fatalf("GetMany(nil) = %v, %v, %v", beers, missing, err)
+	}
+}
+
+func TestGuildGetManyError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/guilds", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"failure","errorMessage":"premium"}`, http.StatusUnauthorized)
+	})
+
+	if _, _, err := client.Guild.GetMany([]string{"a", "b"}); !IsPremiumRequired(err) {
+		t.Fatalf("expected premium error, got %v", err)
+	}
+}
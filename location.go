@@ -1,7 +1,10 @@
 package brewerydb
 
+import "context"
 import "fmt"
+import "iter"
 import "net/http"
+import "time"
 
 // LocationService provides access to the BreweryDB Location API.
 // Use Client.Location.
@@ -19,7 +22,7 @@ type Country struct {
 	ISOThree    string
 	NumberCode  int
 	URLTitle    string
-	CreateDate  string
+	CreateDate  Timestamp
 	// UpdateDate  string
 }
 
@@ -49,10 +52,10 @@ type Location struct {
 	LocationTypeDisplay      string       `url:"-"`
 	CountryISOCode           string       `url:"countryIsoCode"` // Required for UpdateLocation
 	Country                  Country      `url:"-"`
-	CreateDate               string       `url:"-"`
-	UpdateDate               string       `url:"-"`
-	YearOpened               string       `url:"-"`
-	YearClosed               string       `url:"-"`
+	CreateDate               Timestamp    `url:"-"`
+	UpdateDate               Timestamp    `url:"-"`
+	YearOpened               Year         `url:"-"`
+	YearClosed               Year         `url:"-"`
 	BreweryID                string       `url:"-"`
 	Brewery                  Brewery      `url:"-"`
 }
@@ -106,28 +109,28 @@ type LocationListRequest struct {
 	IsClosed       YesNo         `url:"isClosed,omitempty"`
 	LocationType   LocationType  `url:"locationType,omitempty"`
 	CountryISOCode string        `url:"countryIsoCode,omitempty"`
-	Since          int           `url:"since,omitempty"`
+	Since          time.Time     `url:"since,omitempty,unix"` // Max 30 days ago
 	Status         string        `url:"status,omitempty"`
 	Order          LocationOrder `url:"order,omitempty"`
 	Sort           ListSort      `url:"sort,omitempty"`
 }
 
 // LocationList represents a "page" containing a slice of Locations.
-type LocationList struct {
-	NumberOfPages int
-	CurrentPage   int
-	TotalResults  int
-	Locations     []Location `json:"data"`
-}
+type LocationList = Page[Location]
 
 // List retrieves a list of Locations matching the given request.
 // For non-premium users, one of Locality, PostalCode, Region must be set.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/location_index#1
 func (ls *LocationService) List(q *LocationListRequest) (ll LocationList, err error) {
+	return ls.ListContext(context.Background(), q)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (ls *LocationService) ListContext(ctx context.Context, q *LocationListRequest) (ll LocationList, err error) {
 	// GET: /locations
 	var req *http.Request
-	req, err = ls.c.NewRequest("GET", "/locations", q)
+	req, err = ls.c.NewRequestWithContext(ctx, "GET", "/locations", q)
 	if err != nil {
 		return
 	}
@@ -136,41 +139,105 @@ func (ls *LocationService) List(q *LocationListRequest) (ll LocationList, err er
 	return
 }
 
+// All returns an iterator over the Locations on every page matching the
+// given LocationListRequest, starting at its Page. Pages are requested as the
+// iteration proceeds.
+func (ls *LocationService) All(q *LocationListRequest) iter.Seq2[Location, error] {
+	return ls.AllContext(context.Background(), q)
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (ls *LocationService) AllContext(ctx context.Context, q *LocationListRequest) iter.Seq2[Location, error] {
+	var req LocationListRequest
+	if q != nil {
+		req = *q
+	}
+	return paginate(req.Page, func(page int) ([]Location, int, error) {
+		r := req
+		r.Page = page
+		l, err := ls.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Locations on every page matching the given
+// LocationListRequest, starting at its Page. After the first page, the remaining
+// pages are requested concurrently by up to the given number of workers,
+// subject to the Client's QuotaLimiter.
+func (ls *LocationService) FetchAll(q *LocationListRequest, workers int) ([]Location, error) {
+	return ls.FetchAllContext(context.Background(), q, workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (ls *LocationService) FetchAllContext(ctx context.Context, q *LocationListRequest, workers int) ([]Location, error) {
+	var req LocationListRequest
+	if q != nil {
+		req = *q
+	}
+	return fetchPages(ctx, req.Page, workers, func(ctx context.Context, page int) ([]Location, int, error) {
+		r := req
+		r.Page = page
+		l, err := ls.ListContext(ctx, &r)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get retrieves the Location with the given ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/location_index#2
 func (ls *LocationService) Get(locID string) (l Location, err error) {
+	return ls.GetContext(context.Background(), locID)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (ls *LocationService) GetContext(ctx context.Context, locID string) (l Location, err error) {
 	// GET: /location/:locationID
 	var req *http.Request
-	req, err = ls.c.NewRequest("GET", "/location/"+locID, nil)
+	req, err = ls.c.NewRequestWithContext(ctx, "GET", "/location/"+locID, nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Location
-		Message string
-	}{}
+	var resp Envelope[Location]
 	err = ls.c.Do(req, &resp)
 	return resp.Data, err
 }
 
+// GetMany queries for the Locations with the given IDs, which are requested
+// concurrently in batches of 10. It returns the Locations found, in the order
+// of ids, and the IDs that were not found.
+func (ls *LocationService) GetMany(ids []string) ([]Location, []string, error) {
+	return ls.GetManyContext(context.Background(), ids)
+}
+
+// GetManyContext is like GetMany but uses the given Context for the requests.
+func (ls *LocationService) GetManyContext(ctx context.Context, ids []string) ([]Location, []string, error) {
+	id := func(v Location) string { return v.ID }
+	return getMany(ctx, ids, id, func(ctx context.Context, ids string) ([]Location, error) {
+		l, err := ls.ListContext(ctx, &LocationListRequest{Page: 1, IDs: ids})
+		return l.Data, err
+	})
+}
+
 // Update updates the Location having the given ID to match the given Location.
 // The CountryISOCode of the given Location *must* be set.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/location_index#3
 func (ls *LocationService) Update(locID string, l *Location) error {
+	return ls.UpdateContext(context.Background(), locID, l)
+}
+
+// UpdateContext is like Update but uses the given Context for the request.
+func (ls *LocationService) UpdateContext(ctx context.Context, locID string, l *Location) error {
 	// PUT: /location/:locationID
 	if l == nil {
 		return fmt.Errorf("nil Location")
 	}
-	req, err := ls.c.NewRequest("PUT", "/location/"+locID, l)
+	req, err := ls.c.NewRequestWithContext(ctx, "PUT", "/location/"+locID, l)
 	if err != nil {
 		return err
 	}
 
-	// TODO: return any response?
 	return ls.c.Do(req, nil)
 }
 
@@ -178,8 +245,13 @@ func (ls *LocationService) Update(locID string, l *Location) error {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/location_index#4
 func (ls *LocationService) Delete(locID string) error {
+	return ls.DeleteContext(context.Background(), locID)
+}
+
+// DeleteContext is like Delete but uses the given Context for the request.
+func (ls *LocationService) DeleteContext(ctx context.Context, locID string) error {
 	// DELETE: /location/:locationID
-	req, err := ls.c.NewRequest("DELETE", "/location/"+locID, nil)
+	req, err := ls.c.NewRequestWithContext(ctx, "DELETE", "/location/"+locID, nil)
 	if err != nil {
 		return err
 	}
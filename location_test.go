@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestLocationGet(t *testing.T) {
@@ -62,11 +63,11 @@ func TestLocationList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(ll.Locations) <= 0 {
+	if len(ll.Data) <= 0 {
 		t.Fatal("Expected >0 locations")
 	}
 
-	for _, l := range ll.Locations {
+	for _, l := range ll.Data {
 		if n := 6; n != len(l.ID) {
 			t.Fatalf("Location ID len = %d, wanted %d", len(l.ID), n)
 		}
@@ -111,7 +112,7 @@ func makeTestLocation() *Location {
 			ISOThree:    "USA",
 			NumberCode:  840,
 		},
-		YearOpened: "1980",
+		YearOpened: Year{time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)},
 		BreweryID:  "D1UQzj",
 		Brewery:    Brewery{},
 	}
@@ -212,7 +213,7 @@ func ExampleLocationService_List() {
 		if err != nil {
 			panic(err)
 		}
-		for _, loc := range l.Locations {
+		for _, loc := range l.Data {
 			fmt.Println(loc.Name)
 		}
 	}
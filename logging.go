@@ -0,0 +1,76 @@
+package brewerydb
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// redacted replaces the API key in logged URLs and error messages.
+const redacted = "REDACTED"
+
+// redactURL returns the given URL with the value of its "key" query
+// parameter redacted.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	if _, ok := q["key"]; !ok {
+		return u.String()
+	}
+	q.Set("key", redacted)
+	r := *u
+	r.RawQuery = q.Encode()
+	return r.String()
+}
+
+// redactError redacts the API key from the URL in errors returned
+// by http.Client.
+func redactError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		if u, perr := url.Parse(ue.URL); perr == nil {
+			ue.URL = redactURL(u)
+		}
+	}
+	return err
+}
+
+// logAttempt logs a single attempt at sending req. Successful attempts are
+// logged at LevelDebug and failed attempts at LevelWarn.
+func (c *Client) logAttempt(req *http.Request, endpoint string, resp *http.Response, err error, attempt int, d time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.String("url", redactURL(req.URL)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", d),
+	}
+	level := slog.LevelDebug
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			level = slog.LevelWarn
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		level = slog.LevelWarn
+	}
+	c.logger.LogAttrs(req.Context(), level, "brewerydb request", attrs...)
+}
+
+// logRetry logs that req will be sent again after the given delay.
+func (c *Client) logRetry(req *http.Request, endpoint string, attempt int, delay time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.LogAttrs(req.Context(), slog.LevelInfo, "brewerydb retry",
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("delay", delay))
+}
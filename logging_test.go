@@ -0,0 +1,72 @@
+package brewerydb
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithLogger(logger))
+	client.Retry = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := buf.String()
+	if strings.Contains(logs, fakeKey) {
+		t.Fatalf("logs contain API key:\n%s", logs)
+	}
+	for _, want := range []string{
+		`level=WARN msg="brewerydb request" method=GET endpoint=/heartbeat`,
+		"key=" + redacted,
+		"attempt=1",
+		"status=503",
+		`level=INFO msg="brewerydb retry"`,
+		`level=DEBUG msg="brewerydb request"`,
+		"attempt=2",
+		"status=200",
+		"duration=",
+	} {
+		if !strings.Contains(logs, want) {
+			t.Errorf("logs do not contain %q:\n%s", want, logs)
+		}
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithTransport(testTransport{}))
+	err := client.Heartbeat.Heartbeat()
+	if err == nil {
+		t.Fatal("Expected net/http Do error")
+	}
+	if strings.Contains(err.Error(), fakeKey) {
+		t.Fatalf("error %q contains API key", err)
+	}
+	if !strings.Contains(err.Error(), "key="+redacted) {
+		t.Fatalf("error %q does not contain redacted API key", err)
+	}
+}
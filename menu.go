@@ -1,5 +1,7 @@
 package brewerydb
 
+import "context"
+
 // MenuService provides access to the BreweryDB Menu API.
 // Use Client.Menu.
 type MenuService struct {
@@ -8,192 +10,204 @@ type MenuService struct {
 
 // Styles provides a listing of all Beer Styles.
 func (ms *MenuService) Styles() ([]Style, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/styles", nil)
+	return ms.StylesContext(context.Background())
+}
+
+// StylesContext is like Styles but uses the given Context for the request.
+func (ms *MenuService) StylesContext(ctx context.Context) ([]Style, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/styles", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Style
-		Message string
-	}{}
+	var res Envelope[[]Style]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // Categories provides a listing of all Beer Categories.
 func (ms *MenuService) Categories() ([]Category, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/categories", nil)
+	return ms.CategoriesContext(context.Background())
+}
+
+// CategoriesContext is like Categories but uses the given Context for the request.
+func (ms *MenuService) CategoriesContext(ctx context.Context) ([]Category, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/categories", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Category
-		Message string
-	}{}
+	var res Envelope[[]Category]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // Glassware provides a listing of all Beer Glasses.
 func (ms *MenuService) Glassware() ([]Glass, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/glassware", nil)
+	return ms.GlasswareContext(context.Background())
+}
+
+// GlasswareContext is like Glassware but uses the given Context for the request.
+func (ms *MenuService) GlasswareContext(ctx context.Context) ([]Glass, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/glassware", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Glass
-		Message string
-	}{}
+	var res Envelope[[]Glass]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // SRM provides a listing of all SRMs.
 func (ms *MenuService) SRM() ([]SRM, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/srm", nil)
+	return ms.SRMContext(context.Background())
+}
+
+// SRMContext is like SRM but uses the given Context for the request.
+func (ms *MenuService) SRMContext(ctx context.Context) ([]SRM, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/srm", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []SRM
-		Message string
-	}{}
+	var res Envelope[[]SRM]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // BeerAvailability provides a listing of all possible Availability states.
 func (ms *MenuService) BeerAvailability() ([]Availability, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/beer-availability", nil)
+	return ms.BeerAvailabilityContext(context.Background())
+}
+
+// BeerAvailabilityContext is like BeerAvailability but uses the given Context for the request.
+func (ms *MenuService) BeerAvailabilityContext(ctx context.Context) ([]Availability, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/beer-availability", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		Status  string
-		Data    []Availability
-		Message string
-	}{}
+	var resp Envelope[[]Availability]
 	err = ms.c.Do(req, &resp)
 	return resp.Data, err
 }
 
 // Fluidsize provides a listing of all fluidsizes.
 func (ms *MenuService) Fluidsize() ([]Fluidsize, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/fluidsize", nil)
+	return ms.FluidsizeContext(context.Background())
+}
+
+// FluidsizeContext is like Fluidsize but uses the given Context for the request.
+func (ms *MenuService) FluidsizeContext(ctx context.Context) ([]Fluidsize, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/fluidsize", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Fluidsize
-		Message string
-	}{}
+	var res Envelope[[]Fluidsize]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // BeerTemperature provides a mapping of BeerTemperatures to their respective descriptions.
 func (ms *MenuService) BeerTemperature() (map[BeerTemperature]string, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/beer-temperature", nil)
+	return ms.BeerTemperatureContext(context.Background())
+}
+
+// BeerTemperatureContext is like BeerTemperature but uses the given Context for the request.
+func (ms *MenuService) BeerTemperatureContext(ctx context.Context) (map[BeerTemperature]string, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/beer-temperature", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    map[BeerTemperature]string
-		Message string
-	}{}
+	var res Envelope[map[BeerTemperature]string]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // Countries provides a listing of all Countries on Earth.
 func (ms *MenuService) Countries() ([]Country, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/countries", nil)
+	return ms.CountriesContext(context.Background())
+}
+
+// CountriesContext is like Countries but uses the given Context for the request.
+func (ms *MenuService) CountriesContext(ctx context.Context) ([]Country, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/countries", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Country
-		Message string
-	}{}
+	var res Envelope[[]Country]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // Ingredients provides a listing of all Ingredients.
 func (ms *MenuService) Ingredients() ([]Ingredient, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/ingredients", nil)
+	return ms.IngredientsContext(context.Background())
+}
+
+// IngredientsContext is like Ingredients but uses the given Context for the request.
+func (ms *MenuService) IngredientsContext(ctx context.Context) ([]Ingredient, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/ingredients", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    []Ingredient
-		Message string
-	}{}
+	var res Envelope[[]Ingredient]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // LocationTypes provides a mapping of LocationTypes to their respective descriptions.
 func (ms *MenuService) LocationTypes() (map[LocationType]string, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/location-types", nil)
+	return ms.LocationTypesContext(context.Background())
+}
+
+// LocationTypesContext is like LocationTypes but uses the given Context for the request.
+func (ms *MenuService) LocationTypesContext(ctx context.Context) (map[LocationType]string, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/location-types", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    map[LocationType]string
-		Message string
-	}{}
+	var res Envelope[map[LocationType]string]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // FluidsizeVolume provides a mapping of Volumes to their respective descriptions.
 func (ms *MenuService) FluidsizeVolume() (map[Volume]string, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/fluidsize-volume", nil)
+	return ms.FluidsizeVolumeContext(context.Background())
+}
+
+// FluidsizeVolumeContext is like FluidsizeVolume but uses the given Context for the request.
+func (ms *MenuService) FluidsizeVolumeContext(ctx context.Context) (map[Volume]string, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/fluidsize-volume", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    map[Volume]string
-		Message string
-	}{}
+	var res Envelope[map[Volume]string]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
 
 // EventTypes provides a mapping of EventTypes to their respective descriptions.
 func (ms *MenuService) EventTypes() (map[EventType]string, error) {
-	req, err := ms.c.NewRequest("GET", "/menu/event-types", nil)
+	return ms.EventTypesContext(context.Background())
+}
+
+// EventTypesContext is like EventTypes but uses the given Context for the request.
+func (ms *MenuService) EventTypesContext(ctx context.Context) (map[EventType]string, error) {
+	req, err := ms.c.NewRequestWithContext(ctx, "GET", "/menu/event-types", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	res := struct {
-		Status  string
-		Data    map[EventType]string
-		Message string
-	}{}
+	var res Envelope[map[EventType]string]
 	err = ms.c.Do(req, &res)
 	return res.Data, err
 }
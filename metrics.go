@@ -0,0 +1,138 @@
+package brewerydb
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the buckets of each
+// LatencyHistogram in a Metrics snapshot.
+var LatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyHistogram counts request latencies. Counts[i] is the number of
+// requests that took at most LatencyBuckets[i] (and more than the previous
+// bound); the last element of Counts counts slower requests.
+type LatencyHistogram struct {
+	Counts []int64
+	Total  time.Duration
+}
+
+func (h *LatencyHistogram) observe(d time.Duration) {
+	if h.Counts == nil {
+		h.Counts = make([]int64, len(LatencyBuckets)+1)
+	}
+	i := 0
+	for i < len(LatencyBuckets) && d > LatencyBuckets[i] {
+		i++
+	}
+	h.Counts[i]++
+	h.Total += d
+}
+
+// EndpointMetrics contains the request metrics for a single endpoint.
+type EndpointMetrics struct {
+	Requests int64
+	Errors   int64
+	Latency  LatencyHistogram
+}
+
+// Metrics is a snapshot of a Client's request metrics. Every HTTP request
+// is counted, including retries. A request is counted as an error if it
+// failed to produce a response, the response had a non-2xx status, or its
+// envelope reported a "failure" status.
+type Metrics struct {
+	Requests      int64
+	Errors        int64
+	BytesReceived int64
+	// Endpoints maps endpoint templates, e.g. "/beer/:beerId/hops",
+	// to their metrics.
+	Endpoints map[string]EndpointMetrics
+}
+
+// metricsRecorder accumulates Metrics.
+type metricsRecorder struct {
+	mu sync.Mutex
+	m  Metrics
+}
+
+// Metrics returns a snapshot of the Client's request metrics.
+func (c *Client) Metrics() Metrics {
+	c.metrics.mu.Lock()
+	defer c.metrics.mu.Unlock()
+
+	m := c.metrics.m
+	m.Endpoints = make(map[string]EndpointMetrics, len(c.metrics.m.Endpoints))
+	for name, em := range c.metrics.m.Endpoints {
+		em.Latency.Counts = append([]int64(nil), em.Latency.Counts...)
+		m.Endpoints[name] = em
+	}
+	return m
+}
+
+// record adds a single request to the metrics.
+func (r *metricsRecorder) record(endpoint string, latency time.Duration, bytes int, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.m.Endpoints == nil {
+		r.m.Endpoints = make(map[string]EndpointMetrics)
+	}
+	em := r.m.Endpoints[endpoint]
+	em.Requests++
+	em.Latency.observe(latency)
+	r.m.Requests++
+	r.m.BytesReceived += int64(bytes)
+	if failed {
+		em.Errors++
+		r.m.Errors++
+	}
+	r.m.Endpoints[endpoint] = em
+}
+
+// idResources are the path segments of BreweryDB endpoints that are
+// followed by an ID, e.g. "/beer/:beerId".
+var idResources = map[string]bool{
+	"adjunct":       true,
+	"alternatename": true,
+	"awardcategory": true,
+	"awardplace":    true,
+	"beer":          true,
+	"brewery":       true,
+	"category":      true,
+	"event":         true,
+	"feature":       true,
+	"fermentable":   true,
+	"fluidsize":     true,
+	"glass":         true,
+	"guild":         true,
+	"hop":           true,
+	"ingredient":    true,
+	"location":      true,
+	"socialaccount": true,
+	"socialsite":    true,
+	"style":         true,
+	"yeast":         true,
+}
+
+// endpointTemplate replaces the IDs in the given BreweryDB endpoint with
+// placeholders, e.g. "/beer/o9TSOv/hops" becomes "/beer/:beerId/hops".
+func endpointTemplate(endpoint string) string {
+	segs := strings.Split(strings.Trim(endpoint, "/"), "/")
+	// "/search/style" and "/beer/random" contain no IDs
+	for i := 1; i < len(segs); i++ {
+		if prev := segs[i-1]; idResources[prev] && segs[0] != "search" && segs[i] != "random" {
+			segs[i] = ":" + prev + "Id"
+		}
+	}
+	return "/" + strings.Join(segs, "/")
+}
@@ -0,0 +1,112 @@
+package brewerydb
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/beer/missing/hops" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		// BreweryDB may report a failure in a 2xx response
+		if r.URL.Path == "/beer/cBLTUw/hops" {
+			fmt.Fprint(w, `{"status":"failure","errorMessage":"failed"}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":"success","data":[]}`)
+	})
+
+	for _, id := range []string{"o9TSOv", "cBLTUw", "missing"} {
+		client.Beer.ListHops(id)
+	}
+
+	m := client.Metrics()
+	if m.Requests != 3 || m.Errors != 2 {
+		t.Fatalf("Metrics Requests = %d, Errors = %d, want 3, 2", m.Requests, m.Errors)
+	}
+	if m.BytesReceived <= 0 {
+		t.Fatalf("Metrics BytesReceived = %d, want > 0", m.BytesReceived)
+	}
+	em, ok := m.Endpoints["/beer/:beerId/hops"]
+	if !ok {
+		t.Fatalf("Metrics Endpoints = %v, want /beer/:beerId/hops", m.Endpoints)
+	}
+	if em.Requests != 3 || em.Errors != 2 {
+		t.Fatalf("EndpointMetrics Requests = %d, Errors = %d, want 3, 2", em.Requests, em.Errors)
+	}
+	var n int64
+	for _, c := range em.Latency.Counts {
+		n += c
+	}
+	if n != 3 || len(em.Latency.Counts) != len(LatencyBuckets)+1 {
+		t.Fatalf("LatencyHistogram Counts = %v, want 3 observations", em.Latency.Counts)
+	}
+
+	// snapshots must not share state with the Client
+	em.Latency.Counts[0] = 100
+	if client.Metrics().Endpoints["/beer/:beerId/hops"].Latency.Counts[0] == 100 {
+		t.Fatal("Metrics snapshot shares histogram with Client")
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint, want string
+	}{
+		{"/beers", "/beers"},
+		{"/beer/o9TSOv", "/beer/:beerId"},
+		{"/beer/random", "/beer/random"},
+		{"/beer/o9TSOv/hops", "/beer/:beerId/hops"},
+		{"/beer/o9TSOv/hop/3", "/beer/:beerId/hop/:hopId"},
+		{"/brewery/jmGoBA/socialaccount/5/", "/brewery/:breweryId/socialaccount/:socialaccountId"},
+		{"/event/cJio9R/awardcategory/2", "/event/:eventId/awardcategory/:awardcategoryId"},
+		{"/menu/beer-availability", "/menu/beer-availability"},
+		{"/search/style", "/search/style"},
+		{"/search/geo/point", "/search/geo/point"},
+	}
+	for _, tt := range tests {
+		if v := endpointTemplate(tt.endpoint); v != tt.want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", tt.endpoint, v, tt.want)
+		}
+	}
+}
+
+func TestClientConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.JSONWriter = io.Discard
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "400")
+		w.Header().Set("X-Ratelimit-Remaining", "399")
+		fmt.Fprint(w, `{"status":"success","data":{"id":"o9TSOv"}}`)
+	})
+
+	const n = 20
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Beer.Get("o9TSOv"); err != nil {
+				t.Error(err)
+			}
+			client.Metrics()
+			client.Quota()
+		}()
+	}
+	wg.Wait()
+
+	if m := client.Metrics(); m.Requests != n {
+		t.Fatalf("Metrics Requests = %d, want %d", m.Requests, n)
+	}
+}
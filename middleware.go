@@ -0,0 +1,22 @@
+package brewerydb
+
+import "net/http"
+
+// DoFunc performs an http.Request and optionally decodes the JSON response
+// into data, like Client.Do.
+type DoFunc func(req *http.Request, data interface{}) error
+
+// Middleware wraps a DoFunc to inspect or modify requests before calling
+// next, and to inspect or modify the decoded response (data) or error
+// afterwards. A Middleware may also return without calling next.
+// Add Middleware to a Client using WithMiddleware.
+type Middleware func(next DoFunc) DoFunc
+
+// chain wraps the DoFunc in the given Middleware, the first of which
+// is the outermost.
+func chain(mw []Middleware, fn DoFunc) DoFunc {
+	for i := len(mw) - 1; i >= 0; i-- {
+		fn = mw[i](fn)
+	}
+	return fn
+}
@@ -0,0 +1,80 @@
+package brewerydb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("X-Audit"); v != "outer,inner" {
+			t.Errorf("X-Audit = %q, want %q", v, "outer,inner")
+		}
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+
+	var order []string
+	tag := func(name string) Middleware {
+		return func(next DoFunc) DoFunc {
+			return func(req *http.Request, data interface{}) error {
+				order = append(order, name)
+				if v := req.Header.Get("X-Audit"); v != "" {
+					name = v + "," + name
+				}
+				req.Header.Set("X-Audit", name)
+				return next(req, data)
+			}
+		}
+	}
+	annotate := func(next DoFunc) DoFunc {
+		return func(req *http.Request, data interface{}) error {
+			err := next(req, data)
+			if resp, ok := data.(*HeartbeatResponse); ok && err == nil {
+				resp.Message += " (audited)"
+			}
+			return err
+		}
+	}
+
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithMiddleware(annotate, tag("outer"), tag("inner")))
+	req, err := client.NewRequest("GET", "/heartbeat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp HeartbeatResponse
+	if err := client.Do(req, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Request Successful (audited)"; resp.Message != want {
+		t.Fatalf("HeartbeatResponse Message = %q, want %q", resp.Message, want)
+	}
+	if len(order) != 2 || order[0] != "outer" || order[1] != "inner" {
+		t.Fatalf("Middleware order = %v, want [outer inner]", order)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Middleware should have prevented the request")
+	})
+
+	chaos := errors.New("chaos")
+	fail := func(next DoFunc) DoFunc {
+		return func(req *http.Request, data interface{}) error {
+			return chaos
+		}
+	}
+
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithMiddleware(fail))
+	if err := client.Heartbeat.Heartbeat(); err != chaos {
+		t.Fatalf("error = %v, want %v", err, chaos)
+	}
+}
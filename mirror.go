@@ -0,0 +1,344 @@
+package brewerydb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotMirrored is returned when reading an entity that is not in a Mirror.
+var ErrNotMirrored = errors.New("brewerydb: entity not mirrored")
+
+// errNoClient is returned by Sync and Seed on a Mirror created without a Client.
+var errNoClient = errors.New("brewerydb: Mirror has no Client")
+
+// MirroredTypes are the ChangeTypes of the entities kept by a Mirror.
+var MirroredTypes = []ChangeType{ChangeBeer, ChangeBrewery, ChangeLocation, ChangeEvent, ChangeGuild}
+
+// listEndpoints maps each mirrored ChangeType to the endpoint listing it.
+var listEndpoints = map[ChangeType]string{
+	ChangeBeer:     "/beers",
+	ChangeBrewery:  "/breweries",
+	ChangeLocation: "/locations",
+	ChangeEvent:    "/events",
+	ChangeGuild:    "/guilds",
+}
+
+// Mirror is a local copy of the Beers, Breweries, Locations, Events and
+// Guilds in BreweryDB, kept in a directory with one JSON file per entity,
+// as returned by the API, e.g. "beer/o9TSOv.json".
+//
+// Sync seeds the Mirror by listing every entity and afterwards applies the
+// Changes made since the previous Sync. Listing every Beer and Brewery
+// requires a premium membership.
+type Mirror struct {
+	c       *Client
+	dir     string
+	workers int
+	now     func() time.Time
+
+	mu sync.RWMutex
+}
+
+// NewMirror returns a Mirror kept in the given directory, creating it if
+// necessary. The Client is used by Sync and Seed; it may be nil for a Mirror
+// that is only read. Seeding lists entities using up to the given number of workers.
+func NewMirror(c *Client, dir string, workers int) (*Mirror, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Mirror{c: c, dir: dir, workers: workers, now: time.Now}, nil
+}
+
+// Dir returns the directory of the Mirror.
+func (m *Mirror) Dir() string {
+	return m.dir
+}
+
+func (m *Mirror) watermarks() FileWatermarkStore {
+	return FileWatermarkStore(filepath.Join(m.dir, "watermark.json"))
+}
+
+// Watermark returns the Watermark of the last Sync. Its Since is zero if
+// the Mirror has never been seeded.
+func (m *Mirror) Watermark() (Watermark, error) {
+	return m.watermarks().Load()
+}
+
+// Sync brings the Mirror up to date. A new Mirror, or one last synchronized
+// longer than MaxChangeAge ago, is seeded again from scratch since the
+// Changes in between are no longer reported. Otherwise, each Change made
+// since the last Sync is applied by getting the current version of the
+// changed entities, or removing them if they have been deleted.
+func (m *Mirror) Sync(ctx context.Context) error {
+	if m.c == nil {
+		return errNoClient
+	}
+	w, err := m.Watermark()
+	if err != nil {
+		return err
+	}
+	if w.Since.IsZero() && w.Polled.IsZero() {
+		return m.Seed(ctx)
+	}
+
+	p := m.c.Change.NewPoller(m.watermarks())
+	p.now = m.now
+	p.Handle("", "", m.apply)
+	_, err = p.Poll(ctx)
+	if errors.Is(err, ErrWatermarkExpired) {
+		return m.Seed(ctx)
+	}
+	return err
+}
+
+// Seed replaces the contents of the Mirror with every entity listed by
+// BreweryDB. Changes made while seeding are applied by the next Sync.
+func (m *Mirror) Seed(ctx context.Context) error {
+	if m.c == nil {
+		return errNoClient
+	}
+	start := m.now()
+	tmp, err := os.MkdirTemp(m.dir, ".seed")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, t := range MirroredTypes {
+		if err := m.crawl(ctx, t, filepath.Join(tmp, string(t))); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range MirroredTypes {
+		dst := filepath.Join(m.dir, string(t))
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(tmp, string(t)), dst); err != nil {
+			return err
+		}
+	}
+	return m.watermarks().Save(Watermark{Since: start, Polled: start})
+}
+
+// crawl writes every entity of the given type to the given directory.
+func (m *Mirror) crawl(ctx context.Context, t ChangeType, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	items, err := fetchPages(ctx, 1, m.workers, func(ctx context.Context, page int) ([]json.RawMessage, int, error) {
+		req, err := m.c.NewRequestWithContext(ctx, "GET", listEndpoints[t], &pageRequest{page})
+		if err != nil {
+			return nil, 0, err
+		}
+		var l Page[json.RawMessage]
+		err = m.c.Do(req, &l)
+		return l.Data, l.NumberOfPages, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, data := range items {
+		var v struct{ ID string }
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		if err := writeEntity(dir, v.ID, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply updates the Mirror with the entity and sub-entity of a Change.
+func (m *Mirror) apply(ctx context.Context, ev PolledChange) error {
+	if err := m.update(ctx, ev.AttributeName, ev.Attribute.ID, ev.Action); err != nil {
+		return err
+	}
+	return m.update(ctx, ev.SubAttributeName, ev.SubAttribute.ID, ev.SubAction)
+}
+
+func (m *Mirror) update(ctx context.Context, t ChangeType, id string, action ChangeAction) error {
+	if _, ok := listEndpoints[t]; !ok || id == "" {
+		return nil
+	}
+	if action == ChangeDelete {
+		return m.remove(t, id)
+	}
+
+	req, err := m.c.NewRequestWithContext(ctx, "GET", "/"+string(t)+"/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+	var resp Envelope[json.RawMessage]
+	err = m.c.Do(req, &resp)
+	if IsNotFound(err) {
+		return m.remove(t, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dir := filepath.Join(m.dir, string(t))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return writeEntity(dir, id, resp.Data)
+}
+
+func (m *Mirror) remove(t ChangeType, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := os.Remove(entityPath(filepath.Join(m.dir, string(t)), id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func entityPath(dir, id string) string {
+	return filepath.Join(dir, url.PathEscape(id)+".json")
+}
+
+func writeEntity(dir, id string, data json.RawMessage) error {
+	if id == "" {
+		return fmt.Errorf("brewerydb: entity without an id in %s", dir)
+	}
+	path := entityPath(dir, id)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Raw returns the JSON of the entity of the given type and ID, as returned
+// by BreweryDB, or ErrNotMirrored.
+func (m *Mirror) Raw(t ChangeType, id string) (json.RawMessage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data, err := os.ReadFile(entityPath(filepath.Join(m.dir, string(t)), id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotMirrored
+	}
+	return data, err
+}
+
+// AllRaw returns an iterator over the JSON of every entity of the given
+// type in the Mirror, in order of ID.
+func (m *Mirror) AllRaw(t ChangeType) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		entries, err := os.ReadDir(filepath.Join(m.dir, string(t)))
+		if errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, e := range entries {
+			name, ok := strings.CutSuffix(e.Name(), ".json")
+			if !ok {
+				continue
+			}
+			id, err := url.PathUnescape(name)
+			if err != nil {
+				continue
+			}
+			data, err := m.Raw(t, id)
+			if err == ErrNotMirrored {
+				continue
+			}
+			if !yield(data, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func mirrored[T any](m *Mirror, t ChangeType, id string) (v T, err error) {
+	data, err := m.Raw(t, id)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &v)
+	return
+}
+
+func allMirrored[T any](m *Mirror, t ChangeType) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for data, err := range m.AllRaw(t) {
+			var v T
+			if err == nil {
+				err = json.Unmarshal(data, &v)
+			}
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Beer returns the mirrored Beer with the given ID.
+func (m *Mirror) Beer(id string) (Beer, error) {
+	return mirrored[Beer](m, ChangeBeer, id)
+}
+
+// Beers returns an iterator over every mirrored Beer.
+func (m *Mirror) Beers() iter.Seq2[Beer, error] {
+	return allMirrored[Beer](m, ChangeBeer)
+}
+
+// Brewery returns the mirrored Brewery with the given ID.
+func (m *Mirror) Brewery(id string) (Brewery, error) {
+	return mirrored[Brewery](m, ChangeBrewery, id)
+}
+
+// Breweries returns an iterator over every mirrored Brewery.
+func (m *Mirror) Breweries() iter.Seq2[Brewery, error] {
+	return allMirrored[Brewery](m, ChangeBrewery)
+}
+
+// Location returns the mirrored Location with the given ID.
+func (m *Mirror) Location(id string) (Location, error) {
+	return mirrored[Location](m, ChangeLocation, id)
+}
+
+// Locations returns an iterator over every mirrored Location.
+func (m *Mirror) Locations() iter.Seq2[Location, error] {
+	return allMirrored[Location](m, ChangeLocation)
+}
+
+// Event returns the mirrored Event with the given ID.
+func (m *Mirror) Event(id string) (Event, error) {
+	return mirrored[Event](m, ChangeEvent, id)
+}
+
+// Events returns an iterator over every mirrored Event.
+func (m *Mirror) Events() iter.Seq2[Event, error] {
+	return allMirrored[Event](m, ChangeEvent)
+}
+
+// Guild returns the mirrored Guild with the given ID.
+func (m *Mirror) Guild(id string) (Guild, error) {
+	return mirrored[Guild](m, ChangeGuild, id)
+}
+
+// Guilds returns an iterator over every mirrored Guild.
+func (m *Mirror) Guilds() iter.Seq2[Guild, error] {
+	return allMirrored[Guild](m, ChangeGuild)
+}
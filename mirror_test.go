@@ -0,0 +1,218 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCatalog serves the list and get endpoints of the mirrored types from
+// a map of entity type to ID to name.
+type fakeCatalog struct {
+	mu    sync.Mutex
+	names map[string]map[string]string
+	lists int
+}
+
+func (f *fakeCatalog) set(typ, id, name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if name == "" {
+		delete(f.names[typ], id)
+		return
+	}
+	f.names[typ][id] = name
+}
+
+func (f *fakeCatalog) register(mux *http.ServeMux) {
+	for typ, path := range listEndpoints {
+		typ := string(typ)
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.lists++
+			var data []string
+			for id, name := range f.names[typ] {
+				data = append(data, fmt.Sprintf(`{"id":%q,"name":%q}`, id, name))
+			}
+			fmt.Fprintf(w, `{"status":"success","currentPage":1,"numberOfPages":1,"data":[%s]}`, strings.Join(data, ","))
+		})
+		mux.HandleFunc("/"+typ+"/", func(w http.ResponseWriter, r *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			id := strings.TrimPrefix(r.URL.Path, "/"+typ+"/")
+			name, ok := f.names[typ][id]
+			if !ok {
+				http.Error(w, `{"status":"failure","errorMessage":"not found"}`, http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"status":"success","data":{"id":%q,"name":%q}}`, id, name)
+		})
+	}
+}
+
+func TestMirror(t *testing.T) {
+	setup()
+	defer teardown()
+
+	catalog := &fakeCatalog{names: map[string]map[string]string{
+		"beer":     {"o9TSOv": "Raging Bitch", "jmGoBA": "Dragon's Milk"},
+		"brewery":  {"cJio9R": "(512) Brewing Company"},
+		"location": {"qxAQSJ": "Main Brewery"},
+		"event":    {},
+		"guild":    {"k2jMtH": "Brewers Association"},
+	}}
+	catalog.register(mux)
+	feed := &changeFeed{}
+	mux.Handle("/changes", feed)
+
+	now := time.Date(2013, 6, 24, 12, 0, 0, 0, time.UTC)
+	m, err := NewMirror(client, t.TempDir(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := m.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if catalog.lists != 5 {
+		t.Errorf("lists = %d, want 5", catalog.lists)
+	}
+	if b, err := m.Beer("o9TSOv"); err != nil || b.Name != "Raging Bitch" {
+		t.Errorf("Beer = %v, %v", b, err)
+	}
+	if w, err := m.Watermark(); err != nil || !w.Since.Equal(now) {
+		t.Errorf("Watermark = %v, %v, want %v", w, err, now)
+	}
+
+	// Changes are applied by getting or removing the changed entities.
+	catalog.set("beer", "o9TSOv", "Raging Bitch IPA")
+	catalog.set("location", "qxAQSJ", "")
+	catalog.set("location", "Pc2hzu", "Tap Room")
+	catalog.set("event", "cSiXhe", "Great American Beer Festival")
+	feed.add("beer", "o9TSOv", ChangeEdit, "2013-06-24 12:10:00")
+	feed.add("location", "qxAQSJ", ChangeDelete, "2013-06-24 12:11:00")
+	feed.add("event", "cSiXhe", ChangeAdd, "2013-06-24 12:12:00")
+	feed.changes = append(feed.changes, `{"attributeName":"brewery","action":"edit","attribute":{"id":"cJio9R"},`+
+		`"subAttributeName":"location","subAction":"add","subAttribute":{"id":"Pc2hzu"},"changeDate":"2013-06-24 12:13:00"}`)
+	now = now.Add(time.Hour)
+
+	if err := m.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if catalog.lists != 5 {
+		t.Errorf("lists = %d, want no more than 5", catalog.lists)
+	}
+	if b, err := m.Beer("o9TSOv"); err != nil || b.Name != "Raging Bitch IPA" {
+		t.Errorf("Beer = %v, %v", b, err)
+	}
+	if _, err := m.Location("qxAQSJ"); err != ErrNotMirrored {
+		t.Errorf("deleted Location err = %v, want %v", err, ErrNotMirrored)
+	}
+	var locs []string
+	for l, err := range m.Locations() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		locs = append(locs, l.Name)
+	}
+	if want := "[Tap Room]"; fmt.Sprint(locs) != want {
+		t.Errorf("Locations = %v, want %v", locs, want)
+	}
+	if e, err := m.Event("cSiXhe"); err != nil || e.Name != "Great American Beer Festival" {
+		t.Errorf("Event = %v, %v", e, err)
+	}
+
+	// After more than MaxChangeAge the Mirror is seeded again.
+	catalog.set("beer", "jmGoBA", "")
+	now = now.Add(MaxChangeAge + time.Hour)
+	if err := m.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if catalog.lists != 10 {
+		t.Errorf("lists = %d, want 10", catalog.lists)
+	}
+	var beers []string
+	for b, err := range m.Beers() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		beers = append(beers, b.Name)
+	}
+	if want := "[Raging Bitch IPA]"; fmt.Sprint(beers) != want {
+		t.Errorf("Beers = %v, want %v", beers, want)
+	}
+}
+
+func TestMirrorQuietFeed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	catalog := &fakeCatalog{names: map[string]map[string]string{
+		"beer": {"o9TSOv": "Raging Bitch"}, "brewery": {}, "location": {}, "event": {}, "guild": {},
+	}}
+	catalog.register(mux)
+	mux.Handle("/changes", &changeFeed{})
+
+	now := time.Date(2013, 6, 24, 12, 0, 0, 0, time.UTC)
+	m, err := NewMirror(client, t.TempDir(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.now = func() time.Time { return now }
+
+	// Syncing daily without any Changes never seeds the Mirror again.
+	for day := 0; day < 45; day++ {
+		if err := m.Sync(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		now = now.Add(24 * time.Hour)
+	}
+	if catalog.lists != 5 {
+		t.Errorf("lists = %d, want 5", catalog.lists)
+	}
+}
+
+func TestMirrorSeedError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"failure","errorMessage":"premium"}`, http.StatusUnauthorized)
+	})
+
+	m, err := NewMirror(client, t.TempDir(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *APIError
+	if err := m.Sync(context.Background()); !errors.As(err, &apiErr) {
+		t.Fatalf("Sync = %v, want an APIError", err)
+	}
+	if w, err := m.Watermark(); err != nil || !w.Since.IsZero() {
+		t.Errorf("Watermark = %v, %v, want zero", w, err)
+	}
+}
+
+func TestMirrorNoClient(t *testing.T) {
+	m, err := NewMirror(nil, t.TempDir(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Sync(context.Background()); err != errNoClient {
+		t.Errorf("Sync = %v, want %v", err, errNoClient)
+	}
+	if err := m.Seed(context.Background()); err != errNoClient {
+		t.Errorf("Seed = %v, want %v", err, errNoClient)
+	}
+	if _, err := m.Beer("o9TSOv"); err != ErrNotMirrored {
+		t.Errorf("Beer = %v, want %v", err, ErrNotMirrored)
+	}
+}
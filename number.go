@@ -0,0 +1,83 @@
+package brewerydb
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Number is an optional numeric value, e.g. a Beer's ABV. BreweryDB reports
+// numbers inconsistently as JSON numbers, strings or empty values; Number
+// decodes all of them. A Number that is not Valid is unknown.
+type Number struct {
+	Float64 float64
+	Valid   bool
+}
+
+// NewNumber returns a valid Number with the given value.
+func NewNumber(f float64) Number {
+	return Number{Float64: f, Valid: true}
+}
+
+// IsZero reports whether n is unknown.
+func (n Number) IsZero() bool {
+	return !n.Valid
+}
+
+// String returns n in decimal notation, or "" if it is unknown.
+func (n Number) String() string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(n.Float64, 'f', -1, 64)
+}
+
+// Within reports whether n is known and lies within the range [min, max].
+// An unknown bound leaves that side of the range open, e.g.
+// beer.ABV.Within(style.AbvMin, style.AbvMax).
+func (n Number) Within(min, max Number) bool {
+	return n.Valid && (!min.Valid || n.Float64 >= min.Float64) && (!max.Valid || n.Float64 <= max.Float64)
+}
+
+// UnmarshalJSON decodes a JSON number, a string containing a number,
+// an empty string or null into a Number.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	*n = Number{}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			return nil
+		}
+		data = []byte(s)
+	}
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+	*n = NewNumber(f)
+	return nil
+}
+
+// MarshalJSON encodes n as a JSON number, or null if it is unknown.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(n.String()), nil
+}
+
+// EncodeValues adds n to the given url.Values for the given key,
+// unless it is unknown.
+func (n Number) EncodeValues(key string, v *url.Values) error {
+	if n.Valid {
+		v.Set(key, n.String())
+	}
+	return nil
+}
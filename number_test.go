@@ -0,0 +1,77 @@
+package brewerydb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestNumberJSON(t *testing.T) {
+	for _, tt := range []struct {
+		data string
+		want Number
+	}{
+		{`5.5`, NewNumber(5.5)},
+		{`"5.5"`, NewNumber(5.5)},
+		{`"1.050"`, NewNumber(1.05)},
+		{`80`, NewNumber(80)},
+		{`""`, Number{}},
+		{`null`, Number{}},
+	} {
+		var n Number
+		if err := json.Unmarshal([]byte(tt.data), &n); err != nil {
+			t.Errorf("decoding %s: %v", tt.data, err)
+			continue
+		}
+		if n != tt.want {
+			t.Errorf("decoding %s = %+v, want %+v", tt.data, n, tt.want)
+		}
+	}
+
+	var n Number
+	if err := json.Unmarshal([]byte(`"high"`), &n); err == nil {
+		t.Error("expected error decoding non-numeric string")
+	}
+
+	out, err := json.Marshal([]Number{NewNumber(8.7), {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `[8.7,null]` {
+		t.Errorf("JSON = %s", out)
+	}
+}
+
+func TestNumberQueryValues(t *testing.T) {
+	q, err := query.Values(&Beer{Name: "x", ABV: NewNumber(8.7)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := q.Get("abv"); got != "8.7" {
+		t.Errorf("abv = %q, want %q", got, "8.7")
+	}
+	if _, ok := q["ibu"]; ok {
+		t.Error("unknown ibu encoded")
+	}
+}
+
+func TestNumberWithin(t *testing.T) {
+	style := Style{AbvMin: NewNumber(5), AbvMax: NewNumber(7.5)}
+	for _, tt := range []struct {
+		abv  Number
+		min  Number
+		want bool
+	}{
+		{NewNumber(6), style.AbvMin, true},
+		{NewNumber(7.5), style.AbvMin, true},
+		{NewNumber(8), style.AbvMin, false},
+		{NewNumber(4), style.AbvMin, false},
+		{NewNumber(4), Number{}, true},
+		{Number{}, style.AbvMin, false},
+	} {
+		if got := tt.abv.Within(tt.min, style.AbvMax); got != tt.want {
+			t.Errorf("%v.Within(%v, %v) = %v, want %v", tt.abv, tt.min, style.AbvMax, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2015 Joseph Naegele. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package offline serves brewerydb Client requests from a local Mirror and
+// Snapshot instead of the BreweryDB API, for use without connectivity.
+//
+// A Client returned by Backend.Client has the same methods as one using the
+// API, and lists, searches and filters the local entities the same way:
+//
+//	mirror, _ := brewerydb.NewMirror(nil, "mirror", 0)
+//	snap, _ := brewerydb.ImportSnapshot("snapshot")
+//	backend, err := offline.New(mirror, snap)
+//	client := backend.Client()
+//	beer, err := client.Beer.Get("o9TSOv")
+//
+// Beers, Breweries, Locations, Events and Guilds are served from the
+// Mirror, and reference data such as Styles, Hops and the menus from the
+// Snapshot. Endpoints without local data respond as if not found, and
+// requests to add, update or delete entities are rejected.
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/naegelejd/brewerydb"
+	"github.com/naegelejd/brewerydb/internal/fakeapi"
+)
+
+// BaseURL is the base URL of a Client returned by Backend.Client.
+const BaseURL = "http://offline.brewerydb.invalid/v2"
+
+// Backend is an http.RoundTripper serving BreweryDB API requests from the
+// contents of a Mirror and a Snapshot at the time it was created.
+type Backend struct {
+	h *fakeapi.Handler
+}
+
+// New returns a Backend serving the entities in the given Mirror and the
+// reference data in the given Snapshot. Either may be nil.
+func New(m *brewerydb.Mirror, s *brewerydb.Snapshot) (*Backend, error) {
+	h := fakeapi.New()
+	h.Premium = true
+	h.ReadOnly = true
+	if m != nil {
+		if err := loadMirror(h, m); err != nil {
+			return nil, err
+		}
+	}
+	if s != nil {
+		if err := loadSnapshot(h, s); err != nil {
+			return nil, err
+		}
+	}
+	return &Backend{h}, nil
+}
+
+func loadMirror(h *fakeapi.Handler, m *brewerydb.Mirror) error {
+	for _, t := range brewerydb.MirroredTypes {
+		for data, err := range m.AllRaw(t) {
+			if err != nil {
+				return err
+			}
+			var e map[string]interface{}
+			if err := json.Unmarshal(data, &e); err != nil {
+				return err
+			}
+			if err := h.Insert(string(t), e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func loadSnapshot(h *fakeapi.Handler, s *brewerydb.Snapshot) error {
+	menus := map[string]interface{}{
+		"styles":            s.Styles,
+		"categories":        s.Categories,
+		"glassware":         s.Glassware,
+		"srm":               s.SRM,
+		"beer-availability": s.Availability,
+		"fluidsize":         s.Fluidsizes,
+		"countries":         s.Countries,
+		"ingredients":       s.Ingredients,
+	}
+	for name, data := range menus {
+		// menus missing from the Snapshot are not found
+		if reflect.ValueOf(data).IsNil() {
+			continue
+		}
+		if err := h.SetMenu(name, data); err != nil {
+			return err
+		}
+	}
+
+	tables := map[string]interface{}{
+		"style":       s.Styles,
+		"category":    s.Categories,
+		"glass":       s.Glassware,
+		"fluidsize":   s.Fluidsizes,
+		"socialsite":  s.SocialSites,
+		"ingredient":  s.Ingredients,
+		"hop":         s.Hops,
+		"yeast":       s.Yeasts,
+		"fermentable": s.Fermentables,
+		"adjunct":     s.Adjuncts,
+	}
+	for typ, items := range tables {
+		data, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		var es []map[string]interface{}
+		if err := json.Unmarshal(data, &es); err != nil {
+			return err
+		}
+		for _, e := range es {
+			if err := h.Insert(typ, e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Client returns a brewerydb.Client that sends its requests to the
+// Backend. Additional options are applied after the base URL and transport.
+func (b *Backend) Client(opts ...brewerydb.ClientOption) *brewerydb.Client {
+	opts = append([]brewerydb.ClientOption{brewerydb.WithBaseURL(BaseURL), brewerydb.WithTransport(b)}, opts...)
+	return brewerydb.NewClient("", opts...)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (b *Backend) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, basePath)
+
+	w := &responseWriter{header: make(http.Header), status: http.StatusOK}
+	b.h.ServeHTTP(w, r)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+// basePath is the path of BaseURL, removed from requests before routing.
+const basePath = "/v2"
+
+// responseWriter records the response written by the Handler.
+type responseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	wrote  bool
+}
+
+func (w *responseWriter) Header() http.Header { return w.header }
+
+func (w *responseWriter) WriteHeader(status int) {
+	if !w.wrote {
+		w.status = status
+		w.wrote = true
+	}
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
@@ -0,0 +1,159 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/naegelejd/brewerydb"
+	"github.com/naegelejd/brewerydb/brewerydbtest"
+)
+
+// newClients returns a Client for a fake BreweryDB server and an offline
+// Client serving a Mirror of it.
+func newClients(t *testing.T) (online, offline *brewerydb.Client) {
+	srv := brewerydbtest.NewServer(brewerydbtest.WithPremium())
+	t.Cleanup(srv.Close)
+	online = srv.Client()
+
+	m, err := brewerydb.NewMirror(online, t.TempDir(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	snap := &brewerydb.Snapshot{
+		Styles: []brewerydb.Style{
+			{ID: 1, Name: "Classic English-Style Pale Ale", CategoryID: 1},
+			{ID: 15, Name: "Irish-Style Red Ale", CategoryID: 1},
+			{ID: 30, Name: "American-Style India Pale Ale", CategoryID: 3},
+		},
+		Hops: []brewerydb.Hop{{ID: 1, Name: "Admiral", IsNoble: true}},
+	}
+
+	b, err := New(m, snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return online, b.Client()
+}
+
+// beerIDs returns the total results and sorted IDs of a BeerList, since
+// lists are only ordered as requested.
+func beerIDs(bl brewerydb.BeerList) string {
+	var ids []string
+	for _, b := range bl.Data {
+		ids = append(ids, b.ID)
+	}
+	sort.Strings(ids)
+	return fmt.Sprint(bl.TotalResults, ids)
+}
+
+func TestBeers(t *testing.T) {
+	online, offline := newClients(t)
+
+	want, err := online.Beer.Get("o9TSOv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := offline.Beer.Get("o9TSOv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != want.Name || got.StyleID != want.StyleID || got.ABV != want.ABV {
+		t.Errorf("Beer = %+v, want %+v", got, want)
+	}
+	if _, err := offline.Beer.Get("nope"); !brewerydb.IsNotFound(err) {
+		t.Errorf("Get err = %v, want not found", err)
+	}
+
+	for _, q := range []*brewerydb.BeerListRequest{
+		{Page: 1, ABV: brewerydb.AtLeast(8), Order: brewerydb.BeerOrderName},
+		{Page: 1, Name: "Raging Bitch"},
+		{Page: 2, Order: brewerydb.BeerOrderName},
+	} {
+		want, err := online.Beer.List(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := offline.Beer.List(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if beerIDs(got) != beerIDs(want) {
+			t.Errorf("List(%+v) = %v, want %v", q, beerIDs(got), beerIDs(want))
+		}
+	}
+
+	want2, err := online.Search.Beer("bitch", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got2, err := offline.Search.Beer("bitch", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beerIDs(got2) != beerIDs(want2) {
+		t.Errorf("Search.Beer = %v, want %v", beerIDs(got2), beerIDs(want2))
+	}
+
+	if _, err := offline.Beer.Add(&brewerydb.Beer{Name: "Test Ale", StyleID: 15}); err == nil {
+		t.Error("Add succeeded offline")
+	}
+}
+
+func TestReferenceData(t *testing.T) {
+	_, offline := newClients(t)
+
+	sl, err := offline.Style.List(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sl.TotalResults != 3 || sl.Data[2].Name != "American-Style India Pale Ale" {
+		t.Errorf("Style.List = %+v", sl)
+	}
+	s, err := offline.Style.Get(15)
+	if err != nil || s.Name != "Irish-Style Red Ale" {
+		t.Errorf("Style.Get = %+v, %v", s, err)
+	}
+	styles, err := offline.Search.Style("pale", false)
+	if err != nil || len(styles) != 2 {
+		t.Errorf("Search.Style = %+v, %v", styles, err)
+	}
+	styles, err = offline.Menu.Styles()
+	if err != nil || len(styles) != 3 {
+		t.Errorf("Menu.Styles = %+v, %v", styles, err)
+	}
+	if _, err := offline.Menu.Categories(); !brewerydb.IsNotFound(err) {
+		t.Errorf("Menu.Categories err = %v, want not found", err)
+	}
+	hop, err := offline.Hop.Get(1)
+	if err != nil || hop.Name != "Admiral" || !hop.IsNoble {
+		t.Errorf("Hop.Get = %+v, %v", hop, err)
+	}
+	if _, err := offline.Yeast.Get(1); !brewerydb.IsNotFound(err) {
+		t.Errorf("Yeast.Get err = %v, want not found", err)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	b, err := New(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offline := b.Client()
+
+	var apiErr *brewerydb.APIError
+	if _, err := offline.Menu.Styles(); !errors.As(err, &apiErr) || !brewerydb.IsNotFound(err) {
+		t.Errorf("Menu.Styles err = %v, want not found APIError", err)
+	}
+	if _, err := offline.Menu.EventTypes(); !errors.As(err, &apiErr) || !brewerydb.IsNotFound(err) {
+		t.Errorf("Menu.EventTypes err = %v, want not found APIError", err)
+	}
+	if _, err := offline.Beer.Get("o9TSOv"); !errors.As(err, &apiErr) || !brewerydb.IsNotFound(err) {
+		t.Errorf("Beer.Get err = %v, want not found APIError", err)
+	}
+}
@@ -0,0 +1,76 @@
+package brewerydb
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// ClientOption configures a Client. Pass ClientOptions to NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL sets the base URL of the BreweryDB API,
+// e.g. "http://api.brewerydb.com/v2".
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+// A nil http.Client keeps the default. It replaces any http.RoundTripper set
+// by an earlier WithTransport, so pass WithHTTPClient first to use both.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		if hc != nil {
+			c.client = hc
+		}
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to send requests.
+// The Client's http.Client is copied rather than modified.
+// See WithHTTPClient for the order of the two options.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		hc := *c.client
+		hc.Transport = rt
+		c.client = &hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.header.Set("User-Agent", ua)
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithMiddleware adds Middleware around every call to Client.Do.
+// The first Middleware given is the outermost.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// WithLogger sets a Logger that records every request sent by the Client,
+// including retries. The API key is always redacted from logged URLs.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithTracer sets a Tracer that starts a Span for every call to Client.Do.
+func WithTracer(t Tracer) ClientOption {
+	return func(c *Client) {
+		c.tracer = t
+	}
+}
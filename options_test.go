@@ -0,0 +1,108 @@
+package brewerydb
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// countingTransport counts the requests it sends using http.DefaultTransport.
+type countingTransport struct {
+	n int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.n++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClientBaseURL(t *testing.T) {
+	for _, name := range []string{"first", "second"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v2/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, fakeDataHeartbeat)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c := NewClient(fakeKey, WithBaseURL(server.URL+"/v2"))
+			if err := c.Heartbeat.Heartbeat(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestClientHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const ua = "brewerydb-test/1.0"
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("User-Agent"); v != ua {
+			t.Errorf("User-Agent = %q, want %q", v, ua)
+		}
+		if v := r.Header["X-Proxy-Auth"]; len(v) != 2 || v[0] != "a" || v[1] != "b" {
+			t.Errorf("X-Proxy-Auth = %q, want [a b]", v)
+		}
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+
+	client = NewClient(fakeKey,
+		WithBaseURL(server.URL),
+		WithUserAgent(ua),
+		WithHeader("X-Proxy-Auth", "a"),
+		WithHeader("X-Proxy-Auth", "b"))
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientHTTPClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+
+	rt := &countingTransport{}
+	hc := &http.Client{Transport: rt}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithHTTPClient(hc))
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if rt.n != 1 {
+		t.Fatalf("http.Client requests = %d, want 1", rt.n)
+	}
+
+	// WithTransport must not modify the given http.Client
+	other := &countingTransport{}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithHTTPClient(hc), WithTransport(other))
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if other.n != 1 || rt.n != 1 || hc.Transport != rt {
+		t.Fatalf("RoundTripper requests = %d, http.Client requests = %d, want 1, 1", other.n, rt.n)
+	}
+
+	// a nil http.Client keeps the default
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithHTTPClient(nil))
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientDefaultBaseURL(t *testing.T) {
+	req, err := NewClient(fakeKey).NewRequest("GET", "/heartbeat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := DefaultBaseURL + "/heartbeat?key=" + fakeKey; req.URL.String() != want {
+		t.Fatalf("URL = %s, want %s", req.URL, want)
+	}
+}
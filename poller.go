@@ -0,0 +1,378 @@
+package brewerydb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MaxChangeAge is how far back BreweryDB reports Changes.
+const MaxChangeAge = 30 * 24 * time.Hour
+
+// ErrWatermarkExpired is returned by ChangePoller.Poll if the change feed
+// was last read longer than MaxChangeAge ago, so that Changes made since may
+// no longer be reported. The caller should synchronize again from scratch
+// and save a new Watermark.
+var ErrWatermarkExpired = errors.New("brewerydb: watermark older than the change feed")
+
+// Watermark records how far a ChangePoller has read the change feed.
+// Since is the ChangeDate of the last Change dispatched and Seen holds the
+// keys of the Changes dispatched with that same ChangeDate, so that they are
+// not dispatched again when the next poll overlaps. Polled is when the
+// change feed was last read completely.
+type Watermark struct {
+	Since  time.Time
+	Seen   []string
+	Polled time.Time
+}
+
+// WatermarkStore persists the Watermark of a ChangePoller between runs.
+// Load returns the zero Watermark if none has been saved.
+type WatermarkStore interface {
+	Load() (Watermark, error)
+	Save(Watermark) error
+}
+
+// FileWatermarkStore is a WatermarkStore that keeps the Watermark as JSON
+// in the file at the given path.
+type FileWatermarkStore string
+
+// Load implements WatermarkStore.
+func (f FileWatermarkStore) Load() (Watermark, error) {
+	var w Watermark
+	data, err := os.ReadFile(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return w, nil
+	}
+	if err != nil {
+		return w, err
+	}
+	err = json.Unmarshal(data, &w)
+	return w, err
+}
+
+// Save implements WatermarkStore.
+func (f FileWatermarkStore) Save(w Watermark) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	tmp := string(f) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, string(f))
+}
+
+// PolledChange is a single Change dispatched by a ChangePoller. Entity is the
+// changed Beer, Brewery, Event, Guild or Location (as a pointer), fetched
+// after the Change was read when the poller is created with WithRefetch.
+// Entity is nil otherwise, or if the entity was deleted.
+type PolledChange struct {
+	Change
+	Entity interface{}
+}
+
+// ChangeHandler handles a PolledChange. An error stops the poll, and the
+// Change is dispatched again by the next poll.
+type ChangeHandler func(ctx context.Context, ev PolledChange) error
+
+// PollerOption configures a ChangePoller. Pass PollerOptions to
+// ChangeService.NewPoller.
+type PollerOption func(*ChangePoller)
+
+// WithPollInterval sets how long ChangePoller.Run waits between polls.
+// The default is one minute.
+func WithPollInterval(d time.Duration) PollerOption {
+	return func(p *ChangePoller) {
+		p.interval = d
+	}
+}
+
+// WithRefetch makes the ChangePoller get the current version of each
+// changed entity before dispatching its Change.
+func WithRefetch() PollerOption {
+	return func(p *ChangePoller) {
+		p.refetch = true
+	}
+}
+
+// WithPollErrorHandler sets a function called with each error returned by
+// a poll in ChangePoller.Run, which then continues polling. Without one,
+// Run returns the first error.
+func WithPollErrorHandler(fn func(error)) PollerOption {
+	return func(p *ChangePoller) {
+		p.onError = fn
+	}
+}
+
+type handlerKey struct {
+	t ChangeType
+	a ChangeAction
+}
+
+// handlerKeys returns the keys of the handlers matching a ChangeType and
+// ChangeAction, most specific first.
+func handlerKeys(t ChangeType, a ChangeAction) []handlerKey {
+	return []handlerKey{{t, a}, {t, ""}, {"", a}, {"", ""}}
+}
+
+// ChangePoller polls the BreweryDB change feed, dispatching each new Change
+// to the handlers registered for its ChangeType and ChangeAction. Its
+// Watermark is saved to a WatermarkStore after every poll so that a
+// restarted ChangePoller continues where the previous one stopped.
+type ChangePoller struct {
+	c        *Client
+	store    WatermarkStore
+	interval time.Duration
+	refetch  bool
+	onError  func(error)
+	now      func() time.Time
+
+	mu       sync.Mutex
+	handlers map[handlerKey][]ChangeHandler
+	mark     *Watermark
+}
+
+// NewPoller returns a ChangePoller that reads the change feed and keeps its
+// Watermark in the given WatermarkStore. A new Watermark starts MaxChangeAge
+// ago, i.e. with every Change BreweryDB still reports.
+func (cs *ChangeService) NewPoller(store WatermarkStore, opts ...PollerOption) *ChangePoller {
+	p := &ChangePoller{
+		c:        cs.c,
+		store:    store,
+		interval: time.Minute,
+		now:      time.Now,
+		handlers: make(map[handlerKey][]ChangeHandler),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Handle registers a ChangeHandler for Changes of the given ChangeType and
+// ChangeAction. An empty ChangeType or ChangeAction matches any.
+func (p *ChangePoller) Handle(t ChangeType, a ChangeAction, h ChangeHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k := handlerKey{t, a}
+	p.handlers[k] = append(p.handlers[k], h)
+}
+
+// OnBeer registers a handler for Beer Changes with the given ChangeAction.
+// The Beer is nil unless the ChangePoller refetches entities.
+func (p *ChangePoller) OnBeer(a ChangeAction, fn func(context.Context, Change, *Beer) error) {
+	handleEntity(p, ChangeBeer, a, fn)
+}
+
+// OnBrewery registers a handler for Brewery Changes with the given
+// ChangeAction. The Brewery is nil unless the ChangePoller refetches entities.
+func (p *ChangePoller) OnBrewery(a ChangeAction, fn func(context.Context, Change, *Brewery) error) {
+	handleEntity(p, ChangeBrewery, a, fn)
+}
+
+// OnEvent registers a handler for Event Changes with the given ChangeAction.
+// The Event is nil unless the ChangePoller refetches entities.
+func (p *ChangePoller) OnEvent(a ChangeAction, fn func(context.Context, Change, *Event) error) {
+	handleEntity(p, ChangeEvent, a, fn)
+}
+
+// OnGuild registers a handler for Guild Changes with the given ChangeAction.
+// The Guild is nil unless the ChangePoller refetches entities.
+func (p *ChangePoller) OnGuild(a ChangeAction, fn func(context.Context, Change, *Guild) error) {
+	handleEntity(p, ChangeGuild, a, fn)
+}
+
+// OnLocation registers a handler for Location Changes with the given
+// ChangeAction. The Location is nil unless the ChangePoller refetches entities.
+func (p *ChangePoller) OnLocation(a ChangeAction, fn func(context.Context, Change, *Location) error) {
+	handleEntity(p, ChangeLocation, a, fn)
+}
+
+func handleEntity[T any](p *ChangePoller, t ChangeType, a ChangeAction, fn func(context.Context, Change, *T) error) {
+	p.Handle(t, a, func(ctx context.Context, ev PolledChange) error {
+		v, _ := ev.Entity.(*T)
+		return fn(ctx, ev.Change, v)
+	})
+}
+
+// Watermark returns the Watermark of the ChangePoller, loading it from its
+// WatermarkStore if it has not been loaded yet.
+func (p *ChangePoller) Watermark() (Watermark, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
+		return Watermark{}, err
+	}
+	return *p.mark, nil
+}
+
+func (p *ChangePoller) load() error {
+	if p.mark != nil {
+		return nil
+	}
+	w, err := p.store.Load()
+	if err != nil {
+		return err
+	}
+	p.mark = &w
+	return nil
+}
+
+// Run polls the change feed until the given Context is done, waiting the
+// poll interval between polls. It returns the Context's error, or the first
+// error from a poll if no poll error handler is set.
+func (p *ChangePoller) Run(ctx context.Context) error {
+	for {
+		if _, err := p.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if p.onError == nil {
+				return err
+			}
+			p.onError(err)
+		}
+
+		t := time.NewTimer(p.interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Poll reads the Changes made since the Watermark, in order of ChangeDate,
+// and dispatches those not seen before. It saves the Watermark and returns
+// the number of Changes dispatched. If a handler returns an error, the
+// Watermark is saved up to the previous Change and the error is returned.
+//
+// Poll returns ErrWatermarkExpired without reading the feed if it was last
+// read longer than MaxChangeAge ago, since Changes may have been missed.
+func (p *ChangePoller) Poll(ctx context.Context) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
+		return 0, err
+	}
+
+	mark := *p.mark
+	start := p.now()
+	oldest := start.Add(-MaxChangeAge)
+	last := mark.Polled
+	if last.IsZero() {
+		last = mark.Since
+	}
+	if !last.IsZero() && last.Before(oldest) {
+		return 0, ErrWatermarkExpired
+	}
+	// the feed was read since, so nothing before oldest was missed
+	since := mark.Since
+	if since.Before(oldest) {
+		since = oldest
+	}
+
+	var changes []Change
+	for c, err := range p.c.Change.AllContext(ctx, &ChangeListRequest{Since: since}) {
+		if err != nil {
+			return 0, err
+		}
+		changes = append(changes, c)
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].ChangeDate.Before(changes[j].ChangeDate.Time)
+	})
+
+	n := 0
+	for _, c := range changes {
+		key := changeKey(c)
+		if c.ChangeDate.Before(mark.Since) || c.ChangeDate.Equal(mark.Since) && slices.Contains(mark.Seen, key) {
+			continue
+		}
+		if err := p.dispatch(ctx, c); err != nil {
+			return n, p.save(mark, err)
+		}
+		n++
+		if c.ChangeDate.After(mark.Since) {
+			mark = Watermark{Since: c.ChangeDate.Time, Polled: mark.Polled}
+		}
+		mark.Seen = append(mark.Seen, key)
+	}
+	mark.Polled = start
+	return n, p.save(mark, nil)
+}
+
+// save stores the given Watermark, returning err or, failing that, the
+// error from the WatermarkStore.
+func (p *ChangePoller) save(mark Watermark, err error) error {
+	p.mark = &mark
+	if serr := p.store.Save(mark); err == nil {
+		err = serr
+	}
+	return err
+}
+
+func (p *ChangePoller) dispatch(ctx context.Context, c Change) error {
+	var hs []ChangeHandler
+	for _, k := range handlerKeys(c.AttributeName, c.Action) {
+		hs = append(hs, p.handlers[k]...)
+	}
+	if len(hs) == 0 {
+		return nil
+	}
+
+	ev := PolledChange{Change: c}
+	if p.refetch && c.Action != ChangeDelete {
+		v, err := p.fetch(ctx, c.AttributeName, c.Attribute.ID)
+		if err != nil && !IsNotFound(err) {
+			return err
+		}
+		ev.Entity = v
+	}
+	for _, h := range hs {
+		if err := h(ctx, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetch gets the entity of the given ChangeType and ID. It returns a nil
+// entity for an unknown ChangeType.
+func (p *ChangePoller) fetch(ctx context.Context, t ChangeType, id string) (interface{}, error) {
+	switch t {
+	case ChangeBeer:
+		return fetchEntity(ctx, id, p.c.Beer.GetContext)
+	case ChangeBrewery:
+		return fetchEntity(ctx, id, p.c.Brewery.GetContext)
+	case ChangeEvent:
+		return fetchEntity(ctx, id, p.c.Event.GetContext)
+	case ChangeGuild:
+		return fetchEntity(ctx, id, p.c.Guild.GetContext)
+	case ChangeLocation:
+		return fetchEntity(ctx, id, p.c.Location.GetContext)
+	}
+	return nil, nil
+}
+
+func fetchEntity[T any](ctx context.Context, id string, get func(context.Context, string) (T, error)) (interface{}, error) {
+	v, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// changeKey identifies a Change for de-duplication.
+func changeKey(c Change) string {
+	return string(c.AttributeName) + "/" + c.Attribute.ID + "/" + string(c.Action) + "/" +
+		string(c.SubAttributeName) + "/" + c.SubAttribute.ID + "/" + string(c.SubAction)
+}
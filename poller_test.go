@@ -0,0 +1,254 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// changeFeed serves /changes from a list of changes in JSON, in pages of two.
+type changeFeed struct {
+	mu      sync.Mutex
+	changes []string
+	since   []string
+}
+
+func (f *changeFeed) add(attr, id string, action ChangeAction, date string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.changes = append(f.changes, fmt.Sprintf(
+		`{"attributeName":%q,"action":%q,"attribute":{"id":%q},"changeDate":%q}`,
+		attr, action, id, date))
+}
+
+func (f *changeFeed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.since = append(f.since, r.FormValue("since"))
+
+	const size = 2
+	page := 1
+	fmt.Sscan(r.FormValue("p"), &page)
+	pages := (len(f.changes) + size - 1) / size
+	lo, hi := (page-1)*size, page*size
+	if hi > len(f.changes) {
+		hi = len(f.changes)
+	}
+	if lo > hi {
+		lo = hi
+	}
+	fmt.Fprintf(w, `{"status":"success","currentPage":%d,"numberOfPages":%d,"data":[%s]}`,
+		page, pages, strings.Join(f.changes[lo:hi], ","))
+}
+
+func newTestPoller(path string, opts ...PollerOption) *ChangePoller {
+	p := client.Change.NewPoller(FileWatermarkStore(path), opts...)
+	p.now = func() time.Time { return time.Date(2013, 7, 1, 0, 0, 0, 0, time.UTC) }
+	return p
+}
+
+func TestChangePoller(t *testing.T) {
+	setup()
+	defer teardown()
+
+	feed := &changeFeed{}
+	// Out of order, and spanning two pages.
+	feed.add("beer", "jmGoBA", ChangeEdit, "2013-06-24 12:47:17")
+	feed.add("location", "qxAQSJ", ChangeDelete, "2013-06-24 12:40:00")
+	feed.add("beer", "o9TSOv", ChangeAdd, "2013-06-24 12:47:17")
+	mux.Handle("/changes", feed)
+
+	path := filepath.Join(t.TempDir(), "watermark.json")
+	var got []string
+	record := func(_ context.Context, ev PolledChange) error {
+		got = append(got, string(ev.AttributeName)+" "+ev.Attribute.ID+" "+string(ev.Action))
+		return nil
+	}
+
+	p := newTestPoller(path)
+	p.Handle(ChangeBeer, "", record)
+	p.OnLocation(ChangeDelete, func(ctx context.Context, c Change, l *Location) error {
+		if l != nil {
+			t.Errorf("Location = %v, want nil", l)
+		}
+		return record(ctx, PolledChange{Change: c})
+	})
+
+	n, err := p.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"location qxAQSJ delete", "beer jmGoBA edit", "beer o9TSOv add"}
+	if n != 3 || fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Poll dispatched %d: %v, want %v", n, got, want)
+	}
+	if feed.since[0] != "1370044800" {
+		t.Errorf("first since = %s, want 30 days ago", feed.since[0])
+	}
+
+	// A new poller resumes from the saved watermark, skipping the Changes
+	// already dispatched at the same ChangeDate.
+	feed.add("brewery", "cJio9R", ChangeEdit, "2013-06-25 08:00:00")
+	feed.add("beer", "ABCDEF", ChangeEdit, "2013-06-24 12:47:17")
+	got = nil
+	p = newTestPoller(path)
+	p.Handle("", "", record)
+	n, err = p.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"beer ABCDEF edit", "brewery cJio9R edit"}
+	if n != 2 || fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Poll dispatched %d: %v, want %v", n, got, want)
+	}
+	if s := feed.since[len(feed.since)-1]; s != "1372078037" {
+		t.Errorf("since = %s, want the watermark", s)
+	}
+
+	w, err := FileWatermarkStore(path).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2013, 6, 25, 8, 0, 0, 0, time.UTC); !w.Since.Equal(want) || len(w.Seen) != 1 {
+		t.Errorf("Watermark = %+v", w)
+	}
+}
+
+func TestChangePollerHandlerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	feed := &changeFeed{}
+	feed.add("beer", "jmGoBA", ChangeEdit, "2013-06-24 12:00:00")
+	feed.add("beer", "o9TSOv", ChangeEdit, "2013-06-24 13:00:00")
+	mux.Handle("/changes", feed)
+
+	errFail := errors.New("fail")
+	var got []string
+	fail := true
+	p := newTestPoller(filepath.Join(t.TempDir(), "watermark.json"))
+	p.OnBeer(ChangeEdit, func(_ context.Context, c Change, _ *Beer) error {
+		if c.Attribute.ID == "o9TSOv" && fail {
+			return errFail
+		}
+		got = append(got, c.Attribute.ID)
+		return nil
+	})
+
+	if n, err := p.Poll(context.Background()); n != 1 || err != errFail {
+		t.Fatalf("Poll = %d, %v, want 1, %v", n, err, errFail)
+	}
+	fail = false
+	if n, err := p.Poll(context.Background()); n != 1 || err != nil {
+		t.Fatalf("Poll = %d, %v, want 1, nil", n, err)
+	}
+	if want := "[jmGoBA o9TSOv]"; fmt.Sprint(got) != want {
+		t.Errorf("dispatched %v, want %v", got, want)
+	}
+}
+
+func TestChangePollerExpired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	feed := &changeFeed{}
+	mux.Handle("/changes", feed)
+
+	store := FileWatermarkStore(filepath.Join(t.TempDir(), "watermark.json"))
+	old := time.Date(2013, 5, 1, 0, 0, 0, 0, time.UTC)
+	if err := store.Save(Watermark{Since: old}); err != nil {
+		t.Fatal(err)
+	}
+	p := newTestPoller(string(store))
+	if _, err := p.Poll(context.Background()); err != ErrWatermarkExpired {
+		t.Fatalf("Poll = %v, want %v", err, ErrWatermarkExpired)
+	}
+	if len(feed.since) != 0 {
+		t.Errorf("Poll read the feed since %v", feed.since)
+	}
+
+	// An old Change is fine if the feed was read recently.
+	polled := time.Date(2013, 6, 30, 0, 0, 0, 0, time.UTC)
+	if err := store.Save(Watermark{Since: old, Polled: polled}); err != nil {
+		t.Fatal(err)
+	}
+	p = newTestPoller(string(store))
+	if _, err := p.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if feed.since[0] != "1370044800" {
+		t.Errorf("since = %s, want 30 days ago", feed.since[0])
+	}
+	w, err := p.Watermark()
+	if err != nil || !w.Since.Equal(old) || !w.Polled.Equal(p.now()) {
+		t.Errorf("Watermark = %+v, %v", w, err)
+	}
+}
+
+func TestChangePollerRefetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	feed := &changeFeed{}
+	feed.add("beer", "o9TSOv", ChangeEdit, "2013-06-24 12:00:00")
+	feed.add("beer", "gone00", ChangeEdit, "2013-06-24 12:01:00")
+	feed.add("beer", "jmGoBA", ChangeDelete, "2013-06-24 12:02:00")
+	mux.Handle("/changes", feed)
+	mux.HandleFunc("/beer/o9TSOv", func(w http.ResponseWriter, r *http.Request) {
+		checkMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status":"success","data":{"id":"o9TSOv","name":"Flying Dog Raging Bitch"}}`)
+	})
+	mux.HandleFunc("/beer/gone00", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"failure","errorMessage":"not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/beer/jmGoBA", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("deleted Beer was fetched")
+	})
+
+	names := make(map[string]string)
+	p := newTestPoller(filepath.Join(t.TempDir(), "watermark.json"), WithRefetch())
+	p.OnBeer("", func(_ context.Context, c Change, b *Beer) error {
+		names[c.Attribute.ID] = "<nil>"
+		if b != nil {
+			names[c.Attribute.ID] = b.Name
+		}
+		return nil
+	})
+	if _, err := p.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"o9TSOv": "Flying Dog Raging Bitch", "gone00": "<nil>", "jmGoBA": "<nil>"}
+	if fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Errorf("entities = %v, want %v", names, want)
+	}
+}
+
+func TestChangePollerRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	feed := &changeFeed{}
+	mux.Handle("/changes", feed)
+
+	var errs int
+	ctx, cancel := context.WithCancel(context.Background())
+	p := newTestPoller(filepath.Join(t.TempDir(), "missing", "watermark.json"),
+		WithPollInterval(time.Millisecond),
+		WithPollErrorHandler(func(error) {
+			if errs++; errs == 3 {
+				cancel()
+			}
+		}))
+	if err := p.Run(ctx); err != context.Canceled {
+		t.Errorf("Run = %v, want %v", err, context.Canceled)
+	}
+	if errs != 3 {
+		t.Errorf("poll errors = %d, want 3", errs)
+	}
+}
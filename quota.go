@@ -0,0 +1,105 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate-limit headers sent by BreweryDB with every response.
+const (
+	headerRateLimit     = "X-Ratelimit-Limit"
+	headerRateRemaining = "X-Ratelimit-Remaining"
+	headerRateReset     = "X-Ratelimit-Reset" // UNIX timestamp
+)
+
+// ErrQuotaExhausted is returned when a QuotaLimiter refuses to send a request
+// because the API key's request quota has run out.
+var ErrQuotaExhausted = errors.New("brewerydb: request quota exhausted")
+
+// Quota is a snapshot of the API key's request quota, as last reported
+// by BreweryDB in the rate-limit response headers.
+type Quota struct {
+	Limit     int       // Requests allowed per period
+	Remaining int       // Requests remaining in the current period
+	Reset     time.Time // When the current period ends (zero if unknown)
+	Updated   time.Time // When the quota was last reported (zero if never)
+}
+
+// Known reports whether BreweryDB has reported the quota at least once.
+func (q Quota) Known() bool {
+	return !q.Updated.IsZero()
+}
+
+// QuotaLimiter holds back requests before the API key's quota runs out.
+// Set Client.Limiter to enable it.
+type QuotaLimiter struct {
+	// Reserve is the number of requests held back from the quota, e.g.
+	// for use by another process sharing the same API key.
+	Reserve int
+	// Block causes requests to wait until the quota resets instead of
+	// failing immediately with ErrQuotaExhausted.
+	Block bool
+}
+
+// quotaTracker records the most recently reported Quota.
+type quotaTracker struct {
+	mu    sync.Mutex
+	quota Quota
+}
+
+// Quota returns a snapshot of the API key's request quota.
+func (c *Client) Quota() Quota {
+	c.quota.mu.Lock()
+	defer c.quota.mu.Unlock()
+	return c.quota.quota
+}
+
+// update records the quota reported in the given response headers.
+func (t *quotaTracker) update(h http.Header, now time.Time) {
+	limit, err := strconv.Atoi(h.Get(headerRateLimit))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(h.Get(headerRateRemaining))
+	if err != nil {
+		return
+	}
+	q := Quota{Limit: limit, Remaining: remaining, Updated: now}
+	if reset, err := strconv.ParseInt(h.Get(headerRateReset), 10, 64); err == nil {
+		q.Reset = time.Unix(reset, 0)
+	}
+
+	t.mu.Lock()
+	t.quota = q
+	t.mu.Unlock()
+}
+
+// acquire reserves one request from the quota according to the given
+// QuotaLimiter, waiting for the quota to reset if the limiter blocks.
+func (t *quotaTracker) acquire(ctx context.Context, l *QuotaLimiter) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		t.mu.Lock()
+		q := t.quota
+		if !q.Known() || q.Remaining > l.Reserve || (!q.Reset.IsZero() && !time.Now().Before(q.Reset)) {
+			// reserve a request until the next response reports the actual quota
+			t.quota.Remaining--
+			t.mu.Unlock()
+			return nil
+		}
+		t.mu.Unlock()
+
+		if !l.Block || q.Reset.IsZero() {
+			return ErrQuotaExhausted
+		}
+		if err := sleep(ctx, time.Until(q.Reset)); err != nil {
+			return err
+		}
+	}
+}
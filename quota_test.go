@@ -0,0 +1,121 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func handleQuota(remaining int, reset time.Time, requests *int) {
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("X-Ratelimit-Limit", "400")
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+}
+
+func TestQuota(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if q := client.Quota(); q.Known() {
+		t.Fatalf("Quota = %+v before any request, want unknown", q)
+	}
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	requests := 0
+	handleQuota(250, reset, &requests)
+
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	q := client.Quota()
+	if !q.Known() {
+		t.Fatal("Quota unknown after request")
+	}
+	if q.Limit != 400 || q.Remaining != 250 || !q.Reset.Equal(reset) {
+		t.Fatalf("Quota = %+v, want Limit 400, Remaining 250, Reset %v", q, reset)
+	}
+}
+
+func TestQuotaLimiterFailFast(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Limiter = &QuotaLimiter{Reserve: 10}
+
+	requests := 0
+	handleQuota(10, time.Now().Add(time.Hour), &requests)
+
+	// the quota is unknown until the first response, which reports
+	// that only the reserve remains.
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Heartbeat.Heartbeat(); !errors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("error = %v, want %v", err, ErrQuotaExhausted)
+	}
+	if requests != 1 {
+		t.Fatalf("requests = %d, want 1", requests)
+	}
+}
+
+func TestQuotaLimiterBlock(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Limiter = &QuotaLimiter{Block: true}
+
+	requests := 0
+	handleQuota(0, time.Now().Add(time.Hour), &requests)
+
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Heartbeat.HeartbeatContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if requests != 1 {
+		t.Fatalf("requests = %d, want 1", requests)
+	}
+}
+
+func TestQuotaTrackerAcquire(t *testing.T) {
+	var qt quotaTracker
+	l := &QuotaLimiter{Block: true}
+
+	// unknown quota never blocks
+	if err := qt.acquire(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+
+	// exhausted quota blocks until the reset
+	qt.quota = Quota{Limit: 1, Remaining: 0, Reset: time.Now().Add(20 * time.Millisecond), Updated: time.Now()}
+	start := time.Now()
+	if err := qt.acquire(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Fatalf("acquire returned after %v, want it to wait for the reset", elapsed)
+	}
+
+	// without a known reset a blocking limiter fails fast
+	qt.quota = Quota{Limit: 1, Remaining: 0, Updated: time.Now()}
+	if err := qt.acquire(context.Background(), l); !errors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("error = %v, want %v", err, ErrQuotaExhausted)
+	}
+
+	// a nil limiter never blocks
+	if err := qt.acquire(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,119 @@
+package brewerydb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Range is an inclusive range of values used to filter Beers, e.g. by ABV.
+// A Min or Max that is not Valid leaves that side of the range open.
+// Ranges are encoded in BreweryDB's syntax: "5" (exactly 5), "+5" (at least 5),
+// "-10" (at most 10) or "5,8" (between 5 and 8).
+type Range struct {
+	Min Number
+	Max Number
+}
+
+// Exactly returns the Range containing only v.
+func Exactly(v float64) Range {
+	return Range{NewNumber(v), NewNumber(v)}
+}
+
+// AtLeast returns the Range of values greater than or equal to min.
+func AtLeast(min float64) Range {
+	return Range{Min: NewNumber(min)}
+}
+
+// AtMost returns the Range of values less than or equal to max.
+func AtMost(max float64) Range {
+	return Range{Max: NewNumber(max)}
+}
+
+// Between returns the Range of values from min to max.
+func Between(min, max float64) Range {
+	return Range{NewNumber(min), NewNumber(max)}
+}
+
+// ParseRange parses a Range in BreweryDB's syntax.
+func ParseRange(s string) (Range, error) {
+	var r Range
+	var err error
+	switch {
+	case strings.HasPrefix(s, "+"):
+		r.Min, err = parseNumber(s[1:])
+	case strings.HasPrefix(s, "-"):
+		r.Max, err = parseNumber(s[1:])
+	case strings.Contains(s, ","):
+		min, max, _ := strings.Cut(s, ",")
+		if r.Min, err = parseNumber(min); err == nil {
+			r.Max, err = parseNumber(max)
+		}
+	default:
+		r.Min, err = parseNumber(s)
+		r.Max = r.Min
+	}
+	if err != nil {
+		return Range{}, fmt.Errorf("brewerydb: invalid range %q", s)
+	}
+	return r, r.Validate()
+}
+
+func parseNumber(s string) (Number, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	return NewNumber(f), err
+}
+
+// IsZero reports whether r is unbounded, i.e. does not filter anything.
+func (r Range) IsZero() bool {
+	return !r.Min.Valid && !r.Max.Valid
+}
+
+// Contains reports whether n is known and lies within r.
+func (r Range) Contains(n Number) bool {
+	return n.Within(r.Min, r.Max)
+}
+
+// Validate reports whether r can be expressed in BreweryDB's syntax:
+// its bounds must be finite, non-negative numbers with Min <= Max.
+func (r Range) Validate() error {
+	for _, n := range []Number{r.Min, r.Max} {
+		if n.Valid && (math.IsNaN(n.Float64) || math.IsInf(n.Float64, 0) || n.Float64 < 0) {
+			return fmt.Errorf("brewerydb: invalid range bound %v", n.Float64)
+		}
+	}
+	if r.Min.Valid && r.Max.Valid && r.Min.Float64 > r.Max.Float64 {
+		return errors.New("brewerydb: range minimum exceeds maximum")
+	}
+	return nil
+}
+
+// String returns r in BreweryDB's syntax, or "" if it is unbounded.
+func (r Range) String() string {
+	switch {
+	case r.Min.Valid && r.Max.Valid && r.Min.Float64 == r.Max.Float64:
+		return r.Min.String()
+	case r.Min.Valid && r.Max.Valid:
+		return r.Min.String() + "," + r.Max.String()
+	case r.Min.Valid:
+		return "+" + r.Min.String()
+	case r.Max.Valid:
+		return "-" + r.Max.String()
+	}
+	return ""
+}
+
+// EncodeValues adds r to the given url.Values for the given key, unless it
+// is unbounded. It returns an error if r is invalid.
+func (r Range) EncodeValues(key string, v *url.Values) error {
+	if err := r.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	if !r.IsZero() {
+		v.Set(key, r.String())
+	}
+	return nil
+}
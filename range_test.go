@@ -0,0 +1,87 @@
+package brewerydb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestRangeString(t *testing.T) {
+	for _, tt := range []struct {
+		r    Range
+		want string
+	}{
+		{Exactly(5), "5"},
+		{AtLeast(5), "+5"},
+		{AtMost(10), "-10"},
+		{Between(5, 8.5), "5,8.5"},
+		{Range{}, ""},
+	} {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.r, got, tt.want)
+		}
+		if tt.want == "" {
+			continue
+		}
+		r, err := ParseRange(tt.want)
+		if err != nil {
+			t.Errorf("ParseRange(%q): %v", tt.want, err)
+		} else if r != tt.r {
+			t.Errorf("ParseRange(%q) = %+v, want %+v", tt.want, r, tt.r)
+		}
+	}
+}
+
+func TestRangeValidate(t *testing.T) {
+	for _, r := range []Range{
+		Between(8, 5),
+		AtMost(-1),
+		AtLeast(math.NaN()),
+		AtLeast(math.Inf(1)),
+	} {
+		if err := r.Validate(); err == nil {
+			t.Errorf("%+v.Validate() = nil, want error", r)
+		}
+	}
+	for _, s := range []string{"", "+", "5,", "x", "8,5"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("ParseRange(%q) = nil error", s)
+		}
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r := Between(5, 8)
+	if !r.Contains(NewNumber(5)) || !r.Contains(NewNumber(8)) || r.Contains(NewNumber(8.1)) || r.Contains(Number{}) {
+		t.Error("Between(5, 8) contains wrong values")
+	}
+	if !AtLeast(5).Contains(NewNumber(100)) || AtMost(5).Contains(NewNumber(6)) {
+		t.Error("open-ended Range contains wrong values")
+	}
+}
+
+func TestRangeQueryValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	q, err := query.Values(&BeerListRequest{ABV: AtLeast(5), IBU: AtMost(40), Year: Exactly(2015)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Get("abv") != "+5" || q.Get("ibu") != "-40" || q.Get("year") != "2015" {
+		t.Errorf("query = %v", q.Encode())
+	}
+
+	q, err = query.Values(&RandomBeerRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q) != 0 {
+		t.Errorf("unbounded Ranges encoded as %v", q.Encode())
+	}
+
+	if _, err := client.Beer.List(&BeerListRequest{ABV: Between(8, 5)}); err == nil {
+		t.Error("expected error listing Beers with invalid Range")
+	}
+}
@@ -0,0 +1,225 @@
+package brewerydb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records or replays.
+type RecorderMode int
+
+const (
+	// RecordMode sends requests and records each request/response pair.
+	RecordMode RecorderMode = iota
+	// ReplayMode serves recorded responses without sending any requests.
+	ReplayMode
+)
+
+// ErrNotRecorded is returned by a replaying Recorder for requests that
+// have no recorded response.
+var ErrNotRecorded = errors.New("brewerydb: no recorded response for request")
+
+// Cassette is the sequence of request/response pairs stored by a Recorder.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded http.Request. URL never contains the API key.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded http.Response.
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+// Recorder is an http.RoundTripper that records requests and their
+// responses to a cassette file, or replays them from it, so that tests
+// can run offline without an API key. Use it with WithTransport:
+//
+//	rec, err := brewerydb.NewRecorder("testdata/beers.json", brewerydb.ReplayMode, nil)
+//	client := brewerydb.NewClient("", brewerydb.WithTransport(rec))
+//
+// Requests are matched by method, URL (ignoring the API key and the
+// order of query parameters) and body. Identical requests are replayed in
+// the order they were recorded; once exhausted, the last is repeated.
+type Recorder struct {
+	mu        sync.Mutex
+	mode      RecorderMode
+	path      string
+	transport http.RoundTripper
+	cassette  Cassette
+	replayed  []bool
+}
+
+// NewRecorder creates a Recorder for the cassette file at path. In
+// ReplayMode the file must exist. In RecordMode requests are sent using
+// transport, or http.DefaultTransport if nil, and the file is written by Save.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{mode: mode, path: path, transport: transport}
+	if mode == ReplayMode {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("brewerydb: invalid cassette %s: %v", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Cassette returns a copy of the interactions recorded or loaded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.path)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	out, body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	rr := RecordedRequest{Method: req.Method, URL: normalizeURL(req.URL), Body: body}
+
+	if r.mode == ReplayMode {
+		return r.replay(req, rr)
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Request = req
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  rr,
+		Response: RecordedResponse{Status: resp.StatusCode, Header: resp.Header.Clone(), Body: string(data)},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the recorded response to the given request.
+func (r *Recorder) replay(req *http.Request, rr RecordedRequest) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for i, in := range r.cassette.Interactions {
+		if in.Request != rr {
+			continue
+		}
+		match = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, rr.Method, rr.URL)
+	}
+	r.replayed[match] = true
+
+	rec := r.cassette.Interactions[match].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.Status, http.StatusText(rec.Status)),
+		StatusCode:    rec.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(rec.Body))),
+		ContentLength: int64(len(rec.Body)),
+		Request:       req,
+	}, nil
+}
+
+// requestBody returns the body of req along with a request to send in its
+// place. If the body of req cannot be read again using GetBody, it is read
+// into a clone of req, leaving req unmodified.
+func requestBody(req *http.Request) (*http.Request, string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, "", nil
+	}
+	if req.GetBody == nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, "", err
+		}
+		out := req.Clone(req.Context())
+		out.Body = io.NopCloser(bytes.NewReader(data))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+		return out, string(data), nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, "", err
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	return req, string(data), err
+}
+
+// normalizeURL returns u without the API key and with its query
+// parameters sorted.
+func normalizeURL(u *url.URL) string {
+	q := u.Query()
+	q.Del("key")
+	n := *u
+	n.RawQuery = q.Encode()
+	return n.String()
+}
@@ -0,0 +1,131 @@
+package brewerydb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{"status":"success", "data":{"id":"abcdef"}}`)
+			return
+		}
+		w.Header().Set("X-Test", "beers")
+		io.Copy(w, loadTestData("beer.list.json", t))
+	})
+	mux.HandleFunc("/heartbeat/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fakeDataHeartbeat)
+	})
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := NewRecorder(path, RecordMode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithTransport(rec))
+
+	want, err := client.Beer.List(&BeerListRequest{Page: 1, Name: "Dragon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantID, err := client.Beer.Add(makeTestBeer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), fakeKey) {
+		t.Fatal("cassette contains API key")
+	}
+	if n := len(rec.Cassette().Interactions); n != 2 {
+		t.Fatalf("recorded %d interactions, want 2", n)
+	}
+	in := rec.Cassette().Interactions[0]
+	if in.Request.Method != "GET" || in.Response.Status != 200 || in.Response.Header.Get("X-Test") != "beers" {
+		t.Errorf("recorded interaction = %+v", in.Request)
+	}
+
+	// replay without a server or API key
+	server.Close()
+	rec, err = NewRecorder(path, ReplayMode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = NewClient("", WithBaseURL(server.URL), WithTransport(rec))
+
+	for i := 0; i < 2; i++ {
+		got, err := client.Beer.List(&BeerListRequest{Name: "Dragon", Page: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.NumberOfPages != want.NumberOfPages || len(got.Data) != len(want.Data) {
+			t.Fatalf("replayed BeerList = %+v, want %+v", got, want)
+		}
+	}
+	id, err := client.Beer.Add(makeTestBeer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != wantID {
+		t.Errorf("replayed Beer ID = %v, want %v", id, wantID)
+	}
+
+	if err := client.Heartbeat.Heartbeat(); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("expected ErrNotRecorded, got %v", err)
+	}
+}
+
+func TestRecorderRequestBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(w, r.Body)
+	})
+
+	rec, err := NewRecorder(filepath.Join(t.TempDir(), "cassette.json"), RecordMode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A body that cannot be read again with GetBody.
+	body := io.NopCloser(strings.NewReader("name=Test+Ale"))
+	req, err := http.NewRequest("POST", server.URL+"/beers", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if req.Body != body || req.GetBody != nil {
+		t.Error("RoundTrip modified the request")
+	}
+	if got := rec.Cassette().Interactions[0].Request.Body; got != "name=Test+Ale" || string(sent) != got {
+		t.Errorf("recorded body %q, sent %q, want %q", got, sent, "name=Test+Ale")
+	}
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ReplayMode, nil); err == nil {
+		t.Fatal("expected error for missing cassette")
+	}
+}
@@ -0,0 +1,150 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries requests that fail with a
+// transient error: a network error, an HTTP 5xx status or HTTP 429 (Too Many
+// Requests). Set Client.Retry to enable retries.
+//
+// Only idempotent requests (GET, PUT, DELETE) are retried unless
+// RetryNonIdempotent is set. Delays grow exponentially from BaseDelay up to
+// MaxDelay with full jitter. A Retry-After header in the response takes
+// precedence over the computed delay; if it exceeds MaxDelay the request
+// is not retried.
+type RetryPolicy struct {
+	MaxAttempts        int           // Total attempts, including the first. Values < 2 disable retries.
+	BaseDelay          time.Duration // Default: 500ms
+	MaxDelay           time.Duration // Default: 30s
+	RetryNonIdempotent bool          // Also retry POST requests.
+}
+
+// Retry policy defaults.
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	base, max := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+	if max <= 0 {
+		max = defaultRetryMaxDelay
+	}
+	// double the delay until it reaches max, without overflowing
+	d := base
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+		if d > max || d <= 0 {
+			d = max
+		}
+	}
+	if d > max {
+		d = max
+	}
+	return rand.N(d + 1)
+}
+
+// maxDelay returns the longest delay the policy permits between attempts.
+func (p *RetryPolicy) maxDelay() time.Duration {
+	if p.MaxDelay <= 0 {
+		return defaultRetryMaxDelay
+	}
+	return p.MaxDelay
+}
+
+// retryDelay reports whether the given attempt at req, which produced resp
+// and err, should be retried and how long to wait before doing so.
+func (p *RetryPolicy) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests && (resp.StatusCode < 500 || resp.StatusCode == http.StatusNotImplemented) {
+		return 0, false
+	}
+	if d, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return d, d <= p.maxDelay()
+	}
+	return p.backoff(attempt), true
+}
+
+// isIdempotent reports whether requests with the given method may be
+// safely repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date, relative to now.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// rewind returns a copy of req with a fresh Body, so that it can be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// sleep waits for the given duration or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
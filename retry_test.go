@@ -0,0 +1,219 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryTransientErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+		default:
+			fmt.Fprint(w, fakeDataHeartbeat)
+		}
+	})
+
+	if err := client.Heartbeat.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	})
+
+	err := client.Heartbeat.Heartbeat()
+	var e *APIError
+	if !errors.As(err, &e) || e.HTTPStatus != http.StatusBadGateway {
+		t.Fatalf("error = %v, want HTTP 502 APIError", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestRetryNonTransient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	if _, err := client.Beer.Get("o9TSOv"); !IsNotFound(err) {
+		t.Fatalf("error = %v, want not found", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryPOST(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/beer/o9TSOv/hops", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		checkMethod(t, r, "POST")
+		checkPostFormValue(t, r, "hopId", "3")
+		if attempts == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	})
+
+	// POST is not idempotent, so it is not retried by default
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	if err := client.Beer.AddHop("o9TSOv", 3); err == nil {
+		t.Fatal("expected HTTP 503 error")
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+
+	// when opted in, the POST body must be sent again in full
+	attempts = 0
+	client.Retry.RetryNonIdempotent = true
+	if err := client.Beer.AddHop("o9TSOv", 3); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestRetryPUTBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	beer := makeTestBeer()
+	attempts := 0
+	mux.HandleFunc("/beer/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		checkMethod(t, r, "PUT")
+		checkPostFormValue(t, r, "name", beer.Name)
+		if attempts == 1 {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+	})
+
+	if err := client.Beer.Update(beer.ID, beer); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	if err := client.Heartbeat.HeartbeatContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+
+	attempts := 0
+	mux.HandleFunc("/heartbeat", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		http.Error(w, "daily limit exceeded", http.StatusTooManyRequests)
+	})
+
+	if err := client.Heartbeat.Heartbeat(); !IsRateLimited(err) {
+		t.Fatalf("error = %v, want rate limited", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2015, time.June, 18, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		v  string
+		d  time.Duration
+		ok bool
+	}{
+		{"", 0, false},
+		{"7", 7 * time.Second, true},
+		{"-1", 0, false},
+		{"Thu, 18 Jun 2015 12:00:30 GMT", 30 * time.Second, true},
+		{"Thu, 18 Jun 2015 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		if d, ok := retryAfter(tt.v, now); d != tt.d || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.v, d, ok, tt.d, tt.ok)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for retry, max := range []time.Duration{10, 20, 40, 50, 50} {
+		max *= time.Millisecond
+		for i := 0; i < 100; i++ {
+			if d := p.backoff(retry + 1); d < 0 || d > max {
+				t.Fatalf("backoff(%d) = %v, want within [0, %v]", retry+1, d, max)
+			}
+		}
+	}
+}
+
+func TestRetryBackoffLarge(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 40, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+	for retry := 1; retry < p.MaxAttempts; retry++ {
+		if d := p.backoff(retry); d < 0 || d > time.Minute {
+			t.Fatalf("backoff(%d) = %v, want within [0, %v]", retry, d, time.Minute)
+		}
+	}
+}
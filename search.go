@@ -1,5 +1,7 @@
 package brewerydb
 
+import "context"
+
 // SearchService provides access to the BreweryDB Search API.
 // Use Client.Search.
 type SearchService struct {
@@ -24,6 +26,9 @@ type SearchRequest struct {
 	WithLocations      bool
 	WithAlternateNames bool
 	WithIngredients    bool
+	ABV                Range // Beer searches only
+	IBU                Range // Beer searches only
+	Year               Range // Beer searches only
 }
 
 type actualSearchRequest struct {
@@ -36,6 +41,9 @@ type actualSearchRequest struct {
 	WithLocations      YesNo      `url:"withLocations,omitempty"`
 	WithAlternateNames YesNo      `url:"withAlternateNames,omitempty"`
 	WithIngredients    YesNo      `url:"withIngredients,omitempty"`
+	ABV                Range      `url:"abv,omitempty"`
+	IBU                Range      `url:"ibu,omitempty"`
+	Year               Range      `url:"year,omitempty"`
 }
 
 func makeActualSearchRequest(req *SearchRequest, query string, tp searchType) *actualSearchRequest {
@@ -51,37 +59,60 @@ func makeActualSearchRequest(req *SearchRequest, query string, tp searchType) *a
 		WithLocations:      YesNo(req.WithLocations),
 		WithAlternateNames: YesNo(req.WithAlternateNames),
 		WithIngredients:    YesNo(req.WithIngredients),
+		ABV:                req.ABV,
+		IBU:                req.IBU,
+		Year:               req.Year,
 	}
 
 }
 
 // Beer searches for Beers matching the given query.
 func (ss *SearchService) Beer(query string, q *SearchRequest) (bl BeerList, err error) {
-	err = ss.search(query, q, searchBeer, &bl)
+	return ss.BeerContext(context.Background(), query, q)
+}
+
+// BeerContext is like Beer but uses the given Context for the request.
+func (ss *SearchService) BeerContext(ctx context.Context, query string, q *SearchRequest) (bl BeerList, err error) {
+	err = ss.search(ctx, query, q, searchBeer, &bl)
 	return
 }
 
 // Brewery searches for Breweries matching the given query.
 func (ss *SearchService) Brewery(query string, q *SearchRequest) (bl BreweryList, err error) {
-	err = ss.search(query, q, searchBrewery, &bl)
+	return ss.BreweryContext(context.Background(), query, q)
+}
+
+// BreweryContext is like Brewery but uses the given Context for the request.
+func (ss *SearchService) BreweryContext(ctx context.Context, query string, q *SearchRequest) (bl BreweryList, err error) {
+	err = ss.search(ctx, query, q, searchBrewery, &bl)
 	return
 }
 
 // Event searches for Events matching the given query.
 func (ss *SearchService) Event(query string, q *SearchRequest) (el EventList, err error) {
-	err = ss.search(query, q, searchEvent, &el)
+	return ss.EventContext(context.Background(), query, q)
+}
+
+// EventContext is like Event but uses the given Context for the request.
+func (ss *SearchService) EventContext(ctx context.Context, query string, q *SearchRequest) (el EventList, err error) {
+	err = ss.search(ctx, query, q, searchEvent, &el)
 	return
 }
 
 // Guild searches for Guilds matching the given query.
 func (ss *SearchService) Guild(query string, q *SearchRequest) (gl GuildList, err error) {
-	err = ss.search(query, q, searchGuild, &gl)
+	return ss.GuildContext(context.Background(), query, q)
+}
+
+// GuildContext is like Guild but uses the given Context for the request.
+func (ss *SearchService) GuildContext(ctx context.Context, query string, q *SearchRequest) (gl GuildList, err error) {
+	err = ss.search(ctx, query, q, searchGuild, &gl)
 	return
 }
 
-func (ss *SearchService) search(query string, q *SearchRequest, t searchType, data interface{}) error {
+func (ss *SearchService) search(ctx context.Context, query string, q *SearchRequest, t searchType, data interface{}) error {
 	asr := makeActualSearchRequest(q, query, t)
-	req, err := ss.c.NewRequest("GET", "/search", asr)
+	req, err := ss.c.NewRequestWithContext(ctx, "GET", "/search", asr)
 	if err != nil {
 		return err
 	}
@@ -111,17 +142,17 @@ type GeoPointRequest struct {
 // GeoPoint searches for Locations near the geographic coordinate specified in the GeoPointRequest.
 // TODO: pagination??
 func (ss *SearchService) GeoPoint(q *GeoPointRequest) ([]Location, error) {
-	req, err := ss.c.NewRequest("GET", "/search/geo/point", q)
+	return ss.GeoPointContext(context.Background(), q)
+}
+
+// GeoPointContext is like GeoPoint but uses the given Context for the request.
+func (ss *SearchService) GeoPointContext(ctx context.Context, q *GeoPointRequest) ([]Location, error) {
+	req, err := ss.c.NewRequestWithContext(ctx, "GET", "/search/geo/point", q)
 	if err != nil {
 		return nil, err
 	}
 
-	geoPointResult := struct {
-		NumberOfPages int
-		CurrentPage   int
-		TotalResults  int
-		Data          []Location
-	}{}
+	var geoPointResult Page[Location]
 
 	err = ss.c.Do(req, &geoPointResult)
 	return geoPointResult.Data, err
@@ -130,22 +161,22 @@ func (ss *SearchService) GeoPoint(q *GeoPointRequest) ([]Location, error) {
 // Style retrieves one or more Styles matching the given query string.
 // TODO: pagination??
 func (ss *SearchService) Style(query string, withDescriptions bool) ([]Style, error) {
+	return ss.StyleContext(context.Background(), query, withDescriptions)
+}
+
+// StyleContext is like Style but uses the given Context for the request.
+func (ss *SearchService) StyleContext(ctx context.Context, query string, withDescriptions bool) ([]Style, error) {
 	q := struct {
 		Query            string `url:"q"`
 		WithDescriptions YesNo  `url:"withDescriptions,omitempty"`
 	}{query, YesNo(withDescriptions)}
 
-	req, err := ss.c.NewRequest("GET", "/search/style", &q)
+	req, err := ss.c.NewRequestWithContext(ctx, "GET", "/search/style", &q)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		NumberOfPages int
-		CurrentPage   int
-		TotalResults  int
-		Data          []Style
-	}{}
+	var resp Page[Style]
 	err = ss.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -155,24 +186,23 @@ func (ss *SearchService) Style(query string, withDescriptions bool) ([]Style, er
 // TODO: the API doc example shows "data" as being an array of arrays,
 // see: http://www.brewerydb.com/developers/docs-endpoint/search_upc
 func (ss *SearchService) UPC(code uint64) ([]Beer, error) {
+	return ss.UPCContext(context.Background(), code)
+}
+
+// UPCContext is like UPC but uses the given Context for the request.
+func (ss *SearchService) UPCContext(ctx context.Context, code uint64) ([]Beer, error) {
 	q := struct {
 		Code uint64 `url:"code"`
 	}{code}
 
-	req, err := ss.c.NewRequest("GET", "/search/upc", &q)
+	req, err := ss.c.NewRequestWithContext(ctx, "GET", "/search/upc", &q)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := struct {
-		NumberOfPages int
-		CurrentPage   int
-		TotalResults  int
-		Data          [][]Beer
-	}{}
-	err = ss.c.Do(req, &resp)
-	if len(resp.Data) <= 0 {
-		return nil, nil
+	var resp Page[[]Beer]
+	if err = ss.c.Do(req, &resp); err != nil || len(resp.Data) <= 0 {
+		return nil, err
 	}
-	return resp.Data[0], err
+	return resp.Data[0], nil
 }
@@ -23,18 +23,19 @@ func TestSearchBeer(t *testing.T) {
 		checkMethod(t, r, "GET")
 		checkPage(t, r, page)
 		checkFormValue(t, r, "q", query)
+		checkFormValue(t, r, "abv", "+8")
 		// TODO: check more request query values
 		io.Copy(w, data)
 	})
 
-	bl, err := client.Search.Beer(query, &SearchRequest{Page: page})
+	bl, err := client.Search.Beer(query, &SearchRequest{Page: page, ABV: AtLeast(8)})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Beers) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 beers")
 	}
-	for _, b := range bl.Beers {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Beer ID len = %d, want %d", len(b.ID), l)
 		}
@@ -69,10 +70,10 @@ func TestSearchBrewery(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Breweries) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 breweries")
 	}
-	for _, b := range bl.Breweries {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Brewery ID len = %d, want %d", len(b.ID), l)
 		}
@@ -108,10 +109,10 @@ func TestSearchEvent(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Events) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 events")
 	}
-	for _, b := range bl.Events {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Event ID len = %d, want %d", len(b.ID), l)
 		}
@@ -148,10 +149,10 @@ func TestSearchGuild(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(bl.Guilds) <= 0 {
+	if len(bl.Data) <= 0 {
 		t.Fatal("Expected >0 guilds")
 	}
-	for _, b := range bl.Guilds {
+	for _, b := range bl.Data {
 		if l := 6; l != len(b.ID) {
 			t.Fatalf("Guild ID len = %d, want %d", len(b.ID), l)
 		}
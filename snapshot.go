@@ -0,0 +1,266 @@
+package brewerydb
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// SnapshotVersion is the version of the snapshot format written by
+// Snapshot.Export. ImportSnapshot reads snapshots up to this version.
+const SnapshotVersion = 1
+
+// SnapshotManifestName is the name of the manifest file in a snapshot
+// directory.
+const SnapshotManifestName = "manifest.json"
+
+// Snapshot is a point-in-time copy of the BreweryDB reference data: the
+// menus and every Ingredient, Hop, Yeast, Fermentable, Adjunct and
+// SocialSite.
+type Snapshot struct {
+	Created      time.Time
+	Styles       []Style
+	Categories   []Category
+	Glassware    []Glass
+	SRM          []SRM
+	Availability []Availability
+	Fluidsizes   []Fluidsize
+	Countries    []Country
+	Ingredients  []Ingredient
+	Hops         []Hop
+	Yeasts       []Yeast
+	Fermentables []Fermentable
+	Adjuncts     []Adjunct
+	SocialSites  []SocialSite
+}
+
+// SnapshotManifest describes the files of an exported Snapshot.
+type SnapshotManifest struct {
+	Version int
+	Created time.Time
+	Files   []SnapshotFile
+}
+
+// SnapshotFile describes a single gzipped, newline-delimited JSON file of
+// an exported Snapshot, holding one section, e.g. "styles".
+type SnapshotFile struct {
+	Section string
+	Name    string
+	Count   int
+	SHA256  string // Of the gzipped file
+}
+
+// snapshotSection reads, writes and fetches a single section of a Snapshot.
+type snapshotSection struct {
+	name  string
+	fetch func(ctx context.Context, c *Client, s *Snapshot, workers int) error
+	write func(s *Snapshot, w io.Writer) (int, error)
+	read  func(s *Snapshot, r io.Reader) (int, error)
+}
+
+func section[T any](name string, field func(*Snapshot) *[]T, fetch func(context.Context, *Client, int) ([]T, error)) snapshotSection {
+	return snapshotSection{
+		name: name,
+		fetch: func(ctx context.Context, c *Client, s *Snapshot, workers int) error {
+			v, err := fetch(ctx, c, workers)
+			*field(s) = v
+			return err
+		},
+		write: func(s *Snapshot, w io.Writer) (int, error) {
+			enc := json.NewEncoder(w)
+			for _, v := range *field(s) {
+				if err := enc.Encode(v); err != nil {
+					return 0, err
+				}
+			}
+			return len(*field(s)), nil
+		},
+		read: func(s *Snapshot, r io.Reader) (int, error) {
+			dec := json.NewDecoder(r)
+			var vs []T
+			for dec.More() {
+				var v T
+				if err := dec.Decode(&v); err != nil {
+					return 0, err
+				}
+				vs = append(vs, v)
+			}
+			*field(s) = vs
+			return len(vs), nil
+		},
+	}
+}
+
+var snapshotSections = []snapshotSection{
+	section("styles", func(s *Snapshot) *[]Style { return &s.Styles },
+		func(ctx context.Context, c *Client, _ int) ([]Style, error) { return c.Menu.StylesContext(ctx) }),
+	section("categories", func(s *Snapshot) *[]Category { return &s.Categories },
+		func(ctx context.Context, c *Client, _ int) ([]Category, error) { return c.Menu.CategoriesContext(ctx) }),
+	section("glassware", func(s *Snapshot) *[]Glass { return &s.Glassware },
+		func(ctx context.Context, c *Client, _ int) ([]Glass, error) { return c.Menu.GlasswareContext(ctx) }),
+	section("srm", func(s *Snapshot) *[]SRM { return &s.SRM },
+		func(ctx context.Context, c *Client, _ int) ([]SRM, error) { return c.Menu.SRMContext(ctx) }),
+	section("availability", func(s *Snapshot) *[]Availability { return &s.Availability },
+		func(ctx context.Context, c *Client, _ int) ([]Availability, error) {
+			return c.Menu.BeerAvailabilityContext(ctx)
+		}),
+	section("fluidsizes", func(s *Snapshot) *[]Fluidsize { return &s.Fluidsizes },
+		func(ctx context.Context, c *Client, _ int) ([]Fluidsize, error) { return c.Menu.FluidsizeContext(ctx) }),
+	section("countries", func(s *Snapshot) *[]Country { return &s.Countries },
+		func(ctx context.Context, c *Client, _ int) ([]Country, error) { return c.Menu.CountriesContext(ctx) }),
+	section("ingredients", func(s *Snapshot) *[]Ingredient { return &s.Ingredients },
+		func(ctx context.Context, c *Client, _ int) ([]Ingredient, error) {
+			return c.Menu.IngredientsContext(ctx)
+		}),
+	section("hops", func(s *Snapshot) *[]Hop { return &s.Hops },
+		func(ctx context.Context, c *Client, workers int) ([]Hop, error) {
+			return c.Hop.FetchAllContext(ctx, workers)
+		}),
+	section("yeasts", func(s *Snapshot) *[]Yeast { return &s.Yeasts },
+		func(ctx context.Context, c *Client, workers int) ([]Yeast, error) {
+			return c.Yeast.FetchAllContext(ctx, workers)
+		}),
+	section("fermentables", func(s *Snapshot) *[]Fermentable { return &s.Fermentables },
+		func(ctx context.Context, c *Client, workers int) ([]Fermentable, error) {
+			return c.Fermentable.FetchAllContext(ctx, workers)
+		}),
+	section("adjuncts", func(s *Snapshot) *[]Adjunct { return &s.Adjuncts },
+		func(ctx context.Context, c *Client, workers int) ([]Adjunct, error) {
+			return c.Adjunct.FetchAllContext(ctx, workers)
+		}),
+	section("socialsites", func(s *Snapshot) *[]SocialSite { return &s.SocialSites },
+		func(ctx context.Context, c *Client, _ int) ([]SocialSite, error) {
+			return c.SocialSite.ListContext(ctx)
+		}),
+}
+
+// TakeSnapshot retrieves all of the BreweryDB reference data. Paginated
+// lists are requested by up to the given number of workers.
+func (c *Client) TakeSnapshot(workers int) (*Snapshot, error) {
+	return c.TakeSnapshotContext(context.Background(), workers)
+}
+
+// TakeSnapshotContext is like TakeSnapshot but uses the given Context for
+// the requests.
+func (c *Client) TakeSnapshotContext(ctx context.Context, workers int) (*Snapshot, error) {
+	s := &Snapshot{Created: time.Now().UTC()}
+	for _, sec := range snapshotSections {
+		if err := sec.fetch(ctx, c, s, workers); err != nil {
+			return nil, fmt.Errorf("brewerydb: snapshot %s: %w", sec.name, err)
+		}
+	}
+	return s, nil
+}
+
+// Export writes the Snapshot to the given directory, creating it if
+// necessary, as one gzipped newline-delimited JSON file per section,
+// e.g. "styles.ndjson.gz", described by a SnapshotManifest in
+// "manifest.json".
+func (s *Snapshot) Export(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	m := SnapshotManifest{Version: SnapshotVersion, Created: s.Created}
+	for _, sec := range snapshotSections {
+		f := SnapshotFile{Section: sec.name, Name: sec.name + ".ndjson.gz"}
+		var err error
+		f.Count, f.SHA256, err = exportSection(s, sec, filepath.Join(dir, f.Name))
+		if err != nil {
+			return err
+		}
+		m.Files = append(m.Files, f)
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, SnapshotManifestName), data, 0644)
+}
+
+func exportSection(s *Snapshot, sec snapshotSection, path string) (n int, sum string, err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	h := sha256.New()
+	bw := bufio.NewWriter(io.MultiWriter(f, h))
+	zw := gzip.NewWriter(bw)
+	if n, err = sec.write(s, zw); err != nil {
+		return
+	}
+	if err = zw.Close(); err != nil {
+		return
+	}
+	if err = bw.Flush(); err != nil {
+		return
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ImportSnapshot reads a Snapshot written by Snapshot.Export from the given
+// directory, verifying each file against the manifest.
+func ImportSnapshot(dir string) (*Snapshot, error) {
+	data, err := os.ReadFile(filepath.Join(dir, SnapshotManifestName))
+	if err != nil {
+		return nil, err
+	}
+	var m SnapshotManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("brewerydb: snapshot manifest: %w", err)
+	}
+	if m.Version < 1 || m.Version > SnapshotVersion {
+		return nil, fmt.Errorf("brewerydb: unsupported snapshot version %d", m.Version)
+	}
+
+	s := &Snapshot{Created: m.Created}
+	for _, f := range m.Files {
+		for _, sec := range snapshotSections {
+			if sec.name != f.Section {
+				continue
+			}
+			if err := importSection(s, sec, filepath.Join(dir, f.Name), f); err != nil {
+				return nil, fmt.Errorf("brewerydb: snapshot %s: %w", f.Name, err)
+			}
+		}
+	}
+	return s, nil
+}
+
+func importSection(s *Snapshot, sec snapshotSection, path string, f SnapshotFile) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != f.SHA256 {
+		return errors.New("checksum mismatch")
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	n, err := sec.read(s, zr)
+	if err != nil {
+		return err
+	}
+	if n != f.Count {
+		return fmt.Errorf("read %d items, want %d", n, f.Count)
+	}
+	return nil
+}
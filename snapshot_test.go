@@ -0,0 +1,91 @@
+package brewerydb
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func serveTestData(t *testing.T, path, filename string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		checkMethod(t, r, "GET")
+		data := loadTestData(filename, t)
+		defer data.Close()
+		io.Copy(w, data)
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, filename := range map[string]string{
+		"/menu/styles":            "menu.styles.json",
+		"/menu/categories":        "menu.categories.json",
+		"/menu/glassware":         "menu.glassware.json",
+		"/menu/srm":               "menu.srm.json",
+		"/menu/beer-availability": "menu.beer-availability.json",
+		"/menu/fluidsize":         "menu.fluidsize.json",
+		"/menu/countries":         "menu.countries.json",
+		"/menu/ingredients":       "menu.ingredients.json",
+		"/hops":                   "hop.list.json",
+		"/yeasts":                 "yeast.list.json",
+		"/fermentables":           "fermentable.list.json",
+		"/adjuncts":               "adjunct.list.json",
+		"/socialsites":            "socialsite.list.json",
+	} {
+		serveTestData(t, path, filename)
+	}
+
+	s, err := client.TakeSnapshot(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Styles) == 0 || len(s.Countries) == 0 || len(s.SocialSites) == 0 {
+		t.Fatalf("Snapshot has %d Styles, %d Countries, %d SocialSites",
+			len(s.Styles), len(s.Countries), len(s.SocialSites))
+	}
+	// Every page of the fixture is the same.
+	if len(s.Hops)%4 != 0 || len(s.Hops) == 0 {
+		t.Errorf("Snapshot has %d Hops, want all 4 pages", len(s.Hops))
+	}
+
+	dir := t.TempDir()
+	if err := s.Export(dir); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ImportSnapshot(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, s) {
+		t.Error("imported Snapshot differs from the exported Snapshot")
+	}
+}
+
+func TestImportSnapshotErrors(t *testing.T) {
+	s := &Snapshot{Styles: []Style{{ID: 1, Name: "Classic English-Style Pale Ale"}}}
+
+	dir := t.TempDir()
+	if err := s.Export(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "styles.ndjson.gz"), []byte("corrupt"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImportSnapshot(dir); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("ImportSnapshot = %v, want a checksum error", err)
+	}
+
+	manifest := filepath.Join(dir, SnapshotManifestName)
+	if err := os.WriteFile(manifest, []byte(`{"Version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImportSnapshot(dir); err == nil || !strings.Contains(err.Error(), "version") {
+		t.Errorf("ImportSnapshot = %v, want a version error", err)
+	}
+}
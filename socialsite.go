@@ -1,6 +1,7 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -18,8 +19,8 @@ type SocialSite struct {
 	ID         int
 	Name       string
 	Website    string
-	CreateDate string
-	UpdateDate string
+	CreateDate Timestamp
+	UpdateDate Timestamp
 }
 
 // SocialAccount represents a social media account/handle.
@@ -36,18 +37,19 @@ type SocialAccount struct {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/socialsite_index#1
 func (ss *SocialSiteService) List() (sl []SocialSite, err error) {
+	return ss.ListContext(context.Background())
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (ss *SocialSiteService) ListContext(ctx context.Context) (sl []SocialSite, err error) {
 	// GET: /socialsites
 	var req *http.Request
-	req, err = ss.c.NewRequest("GET", "/socialsites", nil)
+	req, err = ss.c.NewRequestWithContext(ctx, "GET", "/socialsites", nil)
 	if err != nil {
 		return
 	}
 
-	socialsitesResp := struct {
-		Status  string
-		Data    []SocialSite
-		Message string
-	}{}
+	var socialsitesResp Envelope[[]SocialSite]
 	err = ss.c.Do(req, &socialsitesResp)
 	return socialsitesResp.Data, err
 }
@@ -56,18 +58,19 @@ func (ss *SocialSiteService) List() (sl []SocialSite, err error) {
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/socialsite_index#2
 func (ss *SocialSiteService) Get(id int) (s SocialSite, err error) {
+	return ss.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (ss *SocialSiteService) GetContext(ctx context.Context, id int) (s SocialSite, err error) {
 	// GET: /socialsite/:socialsiteId
 	var req *http.Request
-	req, err = ss.c.NewRequest("GET", fmt.Sprintf("/socialsite/%d", id), nil)
+	req, err = ss.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/socialsite/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	socialsiteResp := struct {
-		Status  string
-		Data    SocialSite
-		Message string
-	}{}
+	var socialsiteResp Envelope[SocialSite]
 	err = ss.c.Do(req, &socialsiteResp)
 	return socialsiteResp.Data, err
 }
@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -20,35 +22,35 @@ type Style struct {
 	Description string
 	CategoryID  int
 	Category    Category
-	IbuMin      string
-	IbuMax      string
-	SrmMin      string
-	SrmMax      string
-	OgMin       string
-	OgMax       string
-	FgMin       string
-	FgMax       string
-	AbvMin      string
-	AbvMax      string
-	CreateDate  string
-	UpdateDate  string
+	IbuMin      Number
+	IbuMax      Number
+	SrmMin      Number
+	SrmMax      Number
+	OgMin       Number
+	OgMax       Number
+	FgMin       Number
+	FgMax       Number
+	AbvMin      Number
+	AbvMax      Number
+	CreateDate  Timestamp
+	UpdateDate  Timestamp
 }
 
 // StyleList represents a single "page" containing a slice of Styles.
-type StyleList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Styles        []Style `json:"data"`
-}
+type StyleList = Page[Style]
 
 // List returns all Styles on the given page.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/style_index#1
 func (ss *StyleService) List(page int) (sl StyleList, err error) {
+	return ss.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (ss *StyleService) ListContext(ctx context.Context, page int) (sl StyleList, err error) {
 	// GET: /styles
 	var req *http.Request
-	req, err = ss.c.NewRequest("GET", "/styles", &Page{page})
+	req, err = ss.c.NewRequestWithContext(ctx, "GET", "/styles", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -57,22 +59,52 @@ func (ss *StyleService) List(page int) (sl StyleList, err error) {
 	return
 }
 
+// All returns an iterator over the Styles on every page.
+// Pages are requested as the iteration proceeds.
+func (ss *StyleService) All() iter.Seq2[Style, error] {
+	return ss.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (ss *StyleService) AllContext(ctx context.Context) iter.Seq2[Style, error] {
+	return paginate(1, func(page int) ([]Style, int, error) {
+		l, err := ss.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Styles on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (ss *StyleService) FetchAll(workers int) ([]Style, error) {
+	return ss.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (ss *StyleService) FetchAllContext(ctx context.Context, workers int) ([]Style, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Style, int, error) {
+		l, err := ss.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get obtains the Style with the given Style ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/style_index#2
 func (ss *StyleService) Get(id int) (s Style, err error) {
+	return ss.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (ss *StyleService) GetContext(ctx context.Context, id int) (s Style, err error) {
 	// GET: /style/:styleID
 	var req *http.Request
-	req, err = ss.c.NewRequest("GET", fmt.Sprintf("/style/%d", id), nil)
+	req, err = ss.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/style/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Style
-		Message string
-	}{}
+	var resp Envelope[Style]
 	err = ss.c.Do(req, &resp)
 	return resp.Data, err
 }
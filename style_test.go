@@ -53,11 +53,11 @@ func TestStyleList(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(sl.Styles) <= 0 {
+	if len(sl.Data) <= 0 {
 		t.Fatal("Expected >0 styles")
 	}
 
-	for _, s := range sl.Styles {
+	for _, s := range sl.Data {
 		if s.ID <= 0 {
 			t.Fatal("Expected non-zero style ID")
 		}
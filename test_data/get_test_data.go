@@ -7,17 +7,22 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/naegelejd/brewerydb"
 )
 
-var overwriteFiles bool
+var (
+	overwriteFiles bool
+	outputDir      string
+)
 
 func main() {
 	var key string
 	flag.StringVar(&key, "apikey", "", "brewerydb API key (default: $BREWERYDB_API_KEY)")
 	flag.BoolVar(&overwriteFiles, "overwrite", false, "overwrite existing test data")
+	flag.StringVar(&outputDir, "dir", "../brewerydbtest/fixtures", "directory to save test data to")
 	flag.Parse()
 
 	if key == "" {
@@ -103,6 +108,7 @@ func main() {
 type TestDataGetter func(*brewerydb.Client) error
 
 func getTestData(c *brewerydb.Client, filename string, action func(c *brewerydb.Client) error) error {
+	filename = filepath.Join(outputDir, filename)
 	if _, err := os.Stat(filename); err == nil {
 		if overwriteFiles {
 			log.Printf("Overwriting %s\n", filename)
@@ -159,7 +165,7 @@ func beerGet(c *brewerydb.Client) error {
 }
 
 func beerList(c *brewerydb.Client) error {
-	_, err := c.Beer.List(&brewerydb.BeerListRequest{Page: 1, ABV: "8"})
+	_, err := c.Beer.List(&brewerydb.BeerListRequest{Page: 1, ABV: brewerydb.Exactly(8)})
 	return err
 }
 
@@ -174,7 +180,7 @@ func beerListSocialAccounts(c *brewerydb.Client) error {
 }
 
 func beerGetRandom(c *brewerydb.Client) error {
-	_, err := c.Beer.GetRandom(&brewerydb.RandomBeerRequest{ABV: "8"})
+	_, err := c.Beer.GetRandom(&brewerydb.RandomBeerRequest{ABV: brewerydb.Exactly(8)})
 	return err
 }
 
@@ -0,0 +1,75 @@
+package brewerydb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// SpanInfo describes a call to Client.Do for which a span is started.
+type SpanInfo struct {
+	Method   string // HTTP method
+	Endpoint string // Endpoint template, e.g. "/beer/:beerId/hops"
+	Page     int    // Requested page number, or 0 if not paginated
+}
+
+// SpanResult describes the outcome of a traced call to Client.Do.
+type SpanResult struct {
+	Status  int   // HTTP status of the last response, or 0 if none was received
+	Retries int   // Number of times the request was retried
+	Err     error // Error returned by Client.Do
+}
+
+// Span is a single traced call to Client.Do.
+type Span interface {
+	End(SpanResult)
+}
+
+// HeaderInjector may be implemented by a Span to propagate its trace
+// context to BreweryDB (or a proxy) in request headers.
+type HeaderInjector interface {
+	Inject(http.Header)
+}
+
+// Tracer starts a Span for every call to Client.Do. The returned Context,
+// which should carry the Span, is used for the request so that it is
+// visible to Middleware and the http.RoundTripper. Tracer can be
+// implemented on top of any distributed tracing SDK.
+type Tracer interface {
+	StartSpan(ctx context.Context, info SpanInfo) (context.Context, Span)
+}
+
+// callStats collects the outcome of a single call to Client.Do.
+type callStats struct {
+	status  int
+	retries int
+}
+
+type callStatsKey struct{}
+
+// statsFromContext returns the callStats of the traced call to Client.Do
+// associated with ctx, if any.
+func statsFromContext(ctx context.Context) *callStats {
+	cs, _ := ctx.Value(callStatsKey{}).(*callStats)
+	return cs
+}
+
+// trace calls Client.Do's Middleware chain within a Span.
+func (c *Client) trace(req *http.Request, data interface{}) error {
+	info := SpanInfo{
+		Method:   req.Method,
+		Endpoint: endpointTemplate(endpointPath(req.URL, c.baseURL)),
+	}
+	info.Page, _ = strconv.Atoi(req.URL.Query().Get("p"))
+
+	ctx, span := c.tracer.StartSpan(req.Context(), info)
+	cs := &callStats{}
+	req = req.Clone(context.WithValue(ctx, callStatsKey{}, cs))
+	if inj, ok := span.(HeaderInjector); ok {
+		inj.Inject(req.Header)
+	}
+
+	err := c.handler(req, data)
+	span.End(SpanResult{Status: cs.status, Retries: cs.retries, Err: err})
+	return err
+}
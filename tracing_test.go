@@ -0,0 +1,110 @@
+package brewerydb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	info   SpanInfo
+	result SpanResult
+	ended  bool
+}
+
+func (s *testSpan) End(r SpanResult) {
+	s.result = r
+	s.ended = true
+}
+
+func (s *testSpan) Inject(h http.Header) {
+	h.Set("Traceparent", "00-test-"+s.info.Method)
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tr *testTracer) StartSpan(ctx context.Context, info SpanInfo) (context.Context, Span) {
+	s := &testSpan{info: info}
+	tr.spans = append(tr.spans, s)
+	return context.WithValue(ctx, testSpanKey{}, s), s
+}
+
+func TestTracer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/beers", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Traceparent"); got != "00-test-GET" {
+			t.Errorf("Traceparent = %q", got)
+		}
+		attempts++
+		if attempts == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		io.Copy(w, loadTestData("beer.list.json", t))
+	})
+
+	var seen *testSpan
+	tracer := &testTracer{}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithTracer(tracer),
+		WithMiddleware(func(next DoFunc) DoFunc {
+			return func(req *http.Request, data interface{}) error {
+				seen, _ = req.Context().Value(testSpanKey{}).(*testSpan)
+				return next(req, data)
+			}
+		}))
+	client.Retry = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	if _, err := client.Beer.List(&BeerListRequest{Page: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if seen != span {
+		t.Error("span not propagated to Middleware context")
+	}
+	if want := (SpanInfo{Method: "GET", Endpoint: "/beers", Page: 3}); span.info != want {
+		t.Errorf("SpanInfo = %+v, want %+v", span.info, want)
+	}
+	if !span.ended {
+		t.Fatal("span not ended")
+	}
+	if want := (SpanResult{Status: 200, Retries: 1}); span.result != want {
+		t.Errorf("SpanResult = %+v, want %+v", span.result, want)
+	}
+}
+
+func TestTracerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/beer/o9TSOv/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"failure","errorMessage":"not found"}`, http.StatusNotFound)
+	})
+
+	tracer := &testTracer{}
+	client = NewClient(fakeKey, WithBaseURL(server.URL), WithTracer(tracer))
+
+	_, err := client.Beer.Get("o9TSOv")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	span := tracer.spans[0]
+	if span.info.Endpoint != "/beer/:beerId" {
+		t.Errorf("Endpoint = %q", span.info.Endpoint)
+	}
+	if span.result.Status != http.StatusNotFound || span.result.Err != err {
+		t.Errorf("SpanResult = %+v", span.result)
+	}
+}
@@ -0,0 +1,323 @@
+package brewerydb
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultWebhookTolerance is how far the timestamp of a webhook may differ
+// from the current time before a WebhookHandler rejects it. The timestamp
+// is not signed, so this only rejects stale deliveries; replays are
+// rejected by remembering their nonces in a NonceStore.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// Webhook errors reported to a WebhookHandler's error function.
+var (
+	ErrWebhookSignature = errors.New("brewerydb: invalid webhook signature")
+	ErrWebhookExpired   = errors.New("brewerydb: webhook timestamp outside tolerance")
+	ErrWebhookReplayed  = errors.New("brewerydb: webhook nonce already used")
+)
+
+// SignWebhook returns the key BreweryDB sends with a webhook to prove it
+// knows the API key: the hex SHA-1 of the API key followed by the nonce.
+func SignWebhook(apiKey, nonce string) string {
+	sum := sha1.Sum([]byte(apiKey + nonce))
+	return hex.EncodeToString(sum[:])
+}
+
+// WebhookEvent is a single change notification pushed by BreweryDB.
+type WebhookEvent struct {
+	AttributeName    ChangeType
+	AttributeID      string
+	Action           ChangeAction
+	SubAttributeName ChangeType   // Empty if the change has no sub-attribute
+	SubAction        ChangeAction // Empty if the change has no sub-attribute
+	Timestamp        time.Time
+	Nonce            string
+}
+
+// NonceStore remembers the nonces of the webhooks accepted by a
+// WebhookHandler, so that a webhook is never handled twice. Since the nonce
+// is the only signed part of a webhook, nonces must be remembered for good.
+type NonceStore interface {
+	// Use records the given nonce. It reports false if the nonce was
+	// already recorded.
+	Use(nonce string) (bool, error)
+	// Release forgets the given nonce, so that a webhook that failed to be
+	// handled can be delivered again.
+	Release(nonce string) error
+}
+
+// MemoryNonceStore is a NonceStore kept in memory. Its nonces are lost
+// when the process exits, after which replayed webhooks are accepted
+// again. The zero value is ready to use.
+type MemoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]bool
+}
+
+// Use implements NonceStore.
+func (m *MemoryNonceStore) Use(nonce string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.nonces[nonce] {
+		return false, nil
+	}
+	if m.nonces == nil {
+		m.nonces = make(map[string]bool)
+	}
+	m.nonces[nonce] = true
+	return true, nil
+}
+
+// Release implements NonceStore.
+func (m *MemoryNonceStore) Release(nonce string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.nonces, nonce)
+	return nil
+}
+
+// FileNonceStore is a NonceStore that keeps its nonces in a file, one per
+// line, so that they are remembered across restarts.
+type FileNonceStore struct {
+	path string
+	mem  MemoryNonceStore
+}
+
+// NewFileNonceStore returns a FileNonceStore kept in the file at the given
+// path, loading the nonces already saved there.
+func NewFileNonceStore(path string) (*FileNonceStore, error) {
+	f := &FileNonceStore{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for _, n := range strings.Fields(string(data)) {
+		f.mem.Use(n)
+	}
+	return f, nil
+}
+
+// Use implements NonceStore.
+func (f *FileNonceStore) Use(nonce string) (bool, error) {
+	f.mem.mu.Lock()
+	defer f.mem.mu.Unlock()
+	if f.mem.nonces[nonce] {
+		return false, nil
+	}
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return false, err
+	}
+	_, err = file.WriteString(nonce + "\n")
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return false, err
+	}
+	if f.mem.nonces == nil {
+		f.mem.nonces = make(map[string]bool)
+	}
+	f.mem.nonces[nonce] = true
+	return true, nil
+}
+
+// Release implements NonceStore.
+func (f *FileNonceStore) Release(nonce string) error {
+	f.mem.mu.Lock()
+	defer f.mem.mu.Unlock()
+	delete(f.mem.nonces, nonce)
+	var b strings.Builder
+	for n := range f.mem.nonces {
+		b.WriteString(n + "\n")
+	}
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path)
+}
+
+// webhookAction converts an action sent in a webhook to a ChangeAction.
+func webhookAction(s string) ChangeAction {
+	if s == "insert" {
+		return ChangeAdd
+	}
+	return ChangeAction(s)
+}
+
+// parseWebhook decodes a WebhookEvent from the form of a webhook request.
+func parseWebhook(r *http.Request) (WebhookEvent, error) {
+	ev := WebhookEvent{
+		AttributeName: ChangeType(r.PostForm.Get("attribute")),
+		AttributeID:   r.PostForm.Get("attributeId"),
+		Action:        webhookAction(r.PostForm.Get("action")),
+		Nonce:         r.URL.Query().Get("nonce"),
+	}
+	if ev.AttributeName == "" || ev.AttributeID == "" || ev.Action == "" {
+		return ev, errors.New("brewerydb: webhook missing attribute, attributeId or action")
+	}
+	// e.g. "beer-insert", or "none"
+	if sub := r.PostForm.Get("subAction"); sub != "" && sub != "none" {
+		name, action, _ := strings.Cut(sub, "-")
+		ev.SubAttributeName, ev.SubAction = ChangeType(name), webhookAction(action)
+	}
+	ts, err := strconv.ParseInt(r.PostForm.Get("timestamp"), 10, 64)
+	if err != nil {
+		return ev, errors.New("brewerydb: webhook has invalid timestamp")
+	}
+	ev.Timestamp = time.Unix(ts, 0).UTC()
+	return ev, nil
+}
+
+// WebhookFunc handles a WebhookEvent. An error causes the WebhookHandler to
+// respond with an HTTP 500 error so that BreweryDB delivers the webhook
+// again.
+type WebhookFunc func(ctx context.Context, ev WebhookEvent) error
+
+// WebhookOption configures a WebhookHandler. Pass WebhookOptions to
+// NewWebhookHandler.
+type WebhookOption func(*WebhookHandler)
+
+// WithWebhookTolerance sets how far the timestamp of a webhook may differ
+// from the current time.
+func WithWebhookTolerance(d time.Duration) WebhookOption {
+	return func(h *WebhookHandler) {
+		h.tolerance = d
+	}
+}
+
+// WithNonceStore sets the NonceStore remembering the nonces of accepted
+// webhooks. The default is a MemoryNonceStore.
+func WithNonceStore(store NonceStore) WebhookOption {
+	return func(h *WebhookHandler) {
+		h.nonces = store
+	}
+}
+
+// WithWebhookErrorHandler sets a function called with each webhook the
+// WebhookHandler rejects or fails to handle.
+func WithWebhookErrorHandler(fn func(*http.Request, error)) WebhookOption {
+	return func(h *WebhookHandler) {
+		h.onError = fn
+	}
+}
+
+// WebhookHandler is an http.Handler receiving BreweryDB webhooks. It
+// verifies that each webhook is signed with the API key, rejects webhooks
+// that are too old or whose nonce is in its NonceStore, and dispatches the
+// WebhookEvent to the functions registered for its ChangeType and
+// ChangeAction.
+type WebhookHandler struct {
+	apiKey    string
+	tolerance time.Duration
+	onError   func(*http.Request, error)
+	nonces    NonceStore
+	now       func() time.Time
+
+	mu       sync.Mutex
+	handlers map[handlerKey][]WebhookFunc
+}
+
+// NewWebhookHandler returns a WebhookHandler verifying webhooks signed with
+// the given API key.
+func NewWebhookHandler(apiKey string, opts ...WebhookOption) *WebhookHandler {
+	h := &WebhookHandler{
+		apiKey:    apiKey,
+		tolerance: DefaultWebhookTolerance,
+		now:       time.Now,
+		nonces:    &MemoryNonceStore{},
+		handlers:  make(map[handlerKey][]WebhookFunc),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle registers a WebhookFunc for webhooks of the given ChangeType and
+// ChangeAction. An empty ChangeType or ChangeAction matches any.
+func (h *WebhookHandler) Handle(t ChangeType, a ChangeAction, fn WebhookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	k := handlerKey{t, a}
+	h.handlers[k] = append(h.handlers[k], fn)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, err := h.serve(r)
+	if err != nil {
+		if h.onError != nil {
+			h.onError(r, err)
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *WebhookHandler) serve(r *http.Request) (int, error) {
+	if r.Method != "POST" {
+		return http.StatusMethodNotAllowed, errors.New("brewerydb: webhook method must be POST")
+	}
+	q := r.URL.Query()
+	want := SignWebhook(h.apiKey, q.Get("nonce"))
+	if q.Get("nonce") == "" || subtle.ConstantTimeCompare([]byte(q.Get("key")), []byte(want)) != 1 {
+		return http.StatusUnauthorized, ErrWebhookSignature
+	}
+	if err := r.ParseForm(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	ev, err := parseWebhook(r)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	now := h.now()
+	if d := now.Sub(ev.Timestamp); d > h.tolerance || d < -h.tolerance {
+		return http.StatusBadRequest, ErrWebhookExpired
+	}
+	ok, err := h.nonces.Use(ev.Nonce)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if !ok {
+		return http.StatusConflict, ErrWebhookReplayed
+	}
+
+	if err := h.dispatch(r.Context(), ev); err != nil {
+		if rerr := h.nonces.Release(ev.Nonce); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (h *WebhookHandler) dispatch(ctx context.Context, ev WebhookEvent) error {
+	h.mu.Lock()
+	var fns []WebhookFunc
+	for _, k := range handlerKeys(ev.AttributeName, ev.Action) {
+		fns = append(fns, h.handlers[k]...)
+	}
+	h.mu.Unlock()
+
+	for _, fn := range fns {
+		if err := fn(ctx, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
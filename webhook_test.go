@@ -0,0 +1,183 @@
+package brewerydb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func webhookRequest(key, nonce string, form url.Values) *http.Request {
+	target := "/webhook?" + url.Values{"nonce": {nonce}, "key": {key}}.Encode()
+	r := httptest.NewRequest("POST", target, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestWebhookHandler(t *testing.T) {
+	now := time.Date(2015, 7, 18, 12, 0, 0, 0, time.UTC)
+	var got []WebhookEvent
+	var errs []error
+	h := NewWebhookHandler(fakeKey, WithWebhookErrorHandler(func(_ *http.Request, err error) {
+		errs = append(errs, err)
+	}))
+	h.now = func() time.Time { return now }
+	h.Handle(ChangeBeer, ChangeAdd, func(_ context.Context, ev WebhookEvent) error {
+		got = append(got, ev)
+		return nil
+	})
+	h.Handle(ChangeBrewery, "", func(_ context.Context, ev WebhookEvent) error {
+		got = append(got, ev)
+		return nil
+	})
+
+	form := func(attr, id, action, sub string, ts time.Time) url.Values {
+		return url.Values{
+			"attribute":   {attr},
+			"attributeId": {id},
+			"action":      {action},
+			"subAction":   {sub},
+			"timestamp":   {strconv.FormatInt(ts.Unix(), 10)},
+		}
+	}
+	tests := []struct {
+		name   string
+		key    string
+		nonce  string
+		form   url.Values
+		status int
+		err    error
+	}{
+		{"beer insert", SignWebhook(fakeKey, "n1"), "n1", form("beer", "o9TSOv", "insert", "none", now), http.StatusOK, nil},
+		{"brewery sub-action", SignWebhook(fakeKey, "n2"), "n2", form("brewery", "cJio9R", "edit", "location-delete", now.Add(-time.Minute)), http.StatusOK, nil},
+		{"unhandled", SignWebhook(fakeKey, "n3"), "n3", form("guild", "k2jMtH", "edit", "none", now), http.StatusOK, nil},
+		{"bad signature", SignWebhook("wrong key", "n4"), "n4", form("beer", "o9TSOv", "edit", "none", now), http.StatusUnauthorized, ErrWebhookSignature},
+		{"no nonce", SignWebhook(fakeKey, ""), "", form("beer", "o9TSOv", "edit", "none", now), http.StatusUnauthorized, ErrWebhookSignature},
+		{"replay", SignWebhook(fakeKey, "n1"), "n1", form("beer", "o9TSOv", "insert", "none", now), http.StatusConflict, ErrWebhookReplayed},
+		{"expired", SignWebhook(fakeKey, "n5"), "n5", form("beer", "o9TSOv", "edit", "none", now.Add(-time.Hour)), http.StatusBadRequest, ErrWebhookExpired},
+		{"missing id", SignWebhook(fakeKey, "n6"), "n6", form("beer", "", "edit", "none", now), http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		errs = nil
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, webhookRequest(tt.key, tt.nonce, tt.form))
+		if w.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.status)
+		}
+		if tt.err != nil && (len(errs) != 1 || errs[0] != tt.err) {
+			t.Errorf("%s: errors = %v, want %v", tt.name, errs, tt.err)
+		}
+	}
+
+	want := []WebhookEvent{
+		{AttributeName: ChangeBeer, AttributeID: "o9TSOv", Action: ChangeAdd, Timestamp: now, Nonce: "n1"},
+		{AttributeName: ChangeBrewery, AttributeID: "cJio9R", Action: ChangeEdit,
+			SubAttributeName: ChangeLocation, SubAction: ChangeDelete, Timestamp: now.Add(-time.Minute), Nonce: "n2"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("dispatched %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWebhookHandlerError(t *testing.T) {
+	now := time.Now()
+	h := NewWebhookHandler(fakeKey)
+	fail := true
+	h.Handle("", "", func(context.Context, WebhookEvent) error {
+		if fail {
+			return errors.New("fail")
+		}
+		return nil
+	})
+
+	form := url.Values{
+		"attribute":   {"beer"},
+		"attributeId": {"o9TSOv"},
+		"action":      {"edit"},
+		"timestamp":   {strconv.FormatInt(now.Unix(), 10)},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, webhookRequest(SignWebhook(fakeKey, "n1"), "n1", form))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+
+	// A webhook that failed can be delivered again.
+	fail = false
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, webhookRequest(SignWebhook(fakeKey, "n1"), "n1", form))
+	if w.Code != http.StatusOK {
+		t.Errorf("redelivery status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestWebhookReplayAfterTolerance(t *testing.T) {
+	now := time.Date(2015, 7, 18, 12, 0, 0, 0, time.UTC)
+	h := NewWebhookHandler(fakeKey)
+	h.now = func() time.Time { return now }
+	n := 0
+	h.Handle("", "", func(context.Context, WebhookEvent) error {
+		n++
+		return nil
+	})
+
+	form := func(ts time.Time) url.Values {
+		return url.Values{
+			"attribute":   {"beer"},
+			"attributeId": {"o9TSOv"},
+			"action":      {"edit"},
+			"timestamp":   {strconv.FormatInt(ts.Unix(), 10)},
+		}
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, webhookRequest(SignWebhook(fakeKey, "n1"), "n1", form(now)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// The timestamp is not signed, so a replay can rewrite it.
+	now = now.Add(11 * time.Minute)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, webhookRequest(SignWebhook(fakeKey, "n1"), "n1", form(now)))
+	if w.Code != http.StatusConflict || n != 1 {
+		t.Errorf("replay status = %d, handled %d times, want %d, 1", w.Code, n, http.StatusConflict)
+	}
+}
+
+func TestFileNonceStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonces")
+	s, err := NewFileNonceStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []string{"n1", "n2", "n3"} {
+		if ok, err := s.Use(n); !ok || err != nil {
+			t.Fatalf("Use(%q) = %v, %v, want true", n, ok, err)
+		}
+	}
+	if err := s.Release("n2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new store, e.g. after a restart, remembers the nonces.
+	s, err = NewFileNonceStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n, want := range map[string]bool{"n1": false, "n2": true, "n3": false} {
+		if ok, err := s.Use(n); ok != want || err != nil {
+			t.Errorf("Use(%q) = %v, %v, want %v", n, ok, err, want)
+		}
+	}
+}
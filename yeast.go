@@ -1,7 +1,9 @@
 package brewerydb
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -41,25 +43,25 @@ type Yeast struct {
 	ProductID           string
 	Supplier            string
 	YeastFormat         string
-	CreateDate          string
-	UpdateDate          string
+	CreateDate          Timestamp
+	UpdateDate          Timestamp
 }
 
 // YeastList represents a single "page" containing a slice of Yeasts.
-type YeastList struct {
-	CurrentPage   int
-	NumberOfPages int
-	TotalResults  int
-	Yeasts        []Yeast `json:"data"`
-}
+type YeastList = Page[Yeast]
 
 // List returns all Yeasts on the given page.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/yeast_index#1
 func (ys *YeastService) List(page int) (yl YeastList, err error) {
+	return ys.ListContext(context.Background(), page)
+}
+
+// ListContext is like List but uses the given Context for the request.
+func (ys *YeastService) ListContext(ctx context.Context, page int) (yl YeastList, err error) {
 	// GET: /yeasts
 	var req *http.Request
-	req, err = ys.c.NewRequest("GET", "/yeasts", &Page{page})
+	req, err = ys.c.NewRequestWithContext(ctx, "GET", "/yeasts", &pageRequest{page})
 	if err != nil {
 		return
 	}
@@ -68,22 +70,52 @@ func (ys *YeastService) List(page int) (yl YeastList, err error) {
 	return
 }
 
+// All returns an iterator over the Yeasts on every page.
+// Pages are requested as the iteration proceeds.
+func (ys *YeastService) All() iter.Seq2[Yeast, error] {
+	return ys.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given Context for the requests.
+func (ys *YeastService) AllContext(ctx context.Context) iter.Seq2[Yeast, error] {
+	return paginate(1, func(page int) ([]Yeast, int, error) {
+		l, err := ys.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
+// FetchAll returns the Yeasts on every page. After the first page, the
+// remaining pages are requested concurrently by up to the given number of
+// workers, subject to the Client's QuotaLimiter.
+func (ys *YeastService) FetchAll(workers int) ([]Yeast, error) {
+	return ys.FetchAllContext(context.Background(), workers)
+}
+
+// FetchAllContext is like FetchAll but uses the given Context for the requests.
+func (ys *YeastService) FetchAllContext(ctx context.Context, workers int) ([]Yeast, error) {
+	return fetchPages(ctx, 1, workers, func(ctx context.Context, page int) ([]Yeast, int, error) {
+		l, err := ys.ListContext(ctx, page)
+		return l.Data, l.NumberOfPages, err
+	})
+}
+
 // Get obtains the Yeast with the given Yeast ID.
 //
 // See: http://www.brewerydb.com/developers/docs-endpoint/yeast_index#2
 func (ys *YeastService) Get(id int) (y Yeast, err error) {
+	return ys.GetContext(context.Background(), id)
+}
+
+// GetContext is like Get but uses the given Context for the request.
+func (ys *YeastService) GetContext(ctx context.Context, id int) (y Yeast, err error) {
 	// GET: /yeast/:yeastID
 	var req *http.Request
-	req, err = ys.c.NewRequest("GET", fmt.Sprintf("/yeast/%d", id), nil)
+	req, err = ys.c.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/yeast/%d", id), nil)
 	if err != nil {
 		return
 	}
 
-	resp := struct {
-		Status  string
-		Data    Yeast
-		Message string
-	}{}
+	var resp Envelope[Yeast]
 	err = ys.c.Do(req, &resp)
 	return resp.Data, err
 }
@@ -53,10 +53,10 @@ func TestYeastList(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if len(yl.Yeasts) <= 0 {
+	if len(yl.Data) <= 0 {
 		t.Error("Expected >0 yeasts")
 	}
-	for _, y := range yl.Yeasts {
+	for _, y := range yl.Data {
 		if y.ID <= 0 {
 			t.Fatal("Expected non-zero yeast ID")
 		}